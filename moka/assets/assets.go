@@ -0,0 +1,199 @@
+// Package assets downloads the images referenced by a Markdown document and
+// rewrites the image links to point at the local copies.
+package assets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"limpdev/moka/fetch"
+)
+
+// DefaultWorkers is the number of concurrent downloads used by New
+const DefaultWorkers = 4
+
+// imageRe matches Markdown images: ![alt](url "title") or ![alt](<url>)
+var imageRe = regexp.MustCompile(`!\[((?:[^\]\\]|\\.)*)\]\((<[^>]*>|[^)\s]+)((?:\s+"[^"]*")?)\)`)
+
+// extensions maps image media types to file extensions
+var extensions = map[string]string{
+	"image/jpeg":               ".jpg",
+	"image/png":                ".png",
+	"image/gif":                ".gif",
+	"image/webp":               ".webp",
+	"image/svg+xml":            ".svg",
+	"image/avif":               ".avif",
+	"image/bmp":                ".bmp",
+	"image/x-icon":             ".ico",
+	"image/vnd.microsoft.icon": ".ico",
+	"image/tiff":               ".tiff",
+}
+
+// Downloader saves images with a bounded pool of workers
+type Downloader struct {
+	Fetcher *fetch.Fetcher
+	Workers int
+}
+
+// Failure records an image that could not be saved
+type Failure struct {
+	URL string
+	Err error
+}
+
+func (f Failure) Error() string {
+	return fmt.Sprintf("%s: %s", f.URL, f.Err)
+}
+
+// Report summarises a Localize run
+type Report struct {
+	Saved    map[string]string // Image URL -> file path on disk
+	Failures []Failure
+}
+
+// New returns a Downloader using fetcher and DefaultWorkers
+func New(fetcher *fetch.Fetcher) *Downloader {
+	return &Downloader{Fetcher: fetcher, Workers: DefaultWorkers}
+}
+
+// Localize downloads every remote image in markdown into dir and returns the
+// Markdown with those images pointing at the local files, relative to
+// markdownDir (the directory the Markdown will be written to). Files are
+// named by content hash, so identical images are stored once and names stay
+// stable between runs. Images that fail are left linked to their URL and
+// listed in the report; they never abort the conversion.
+func (d *Downloader) Localize(ctx context.Context, markdown, dir, markdownDir string) (string, Report) {
+	report := Report{Saved: map[string]string{}}
+
+	var urls []string
+	seen := map[string]bool{}
+	for _, m := range imageRe.FindAllStringSubmatch(markdown, -1) {
+		u := strings.Trim(m[2], "<>")
+		if !seen[u] && isRemote(u) {
+			seen[u] = true
+			urls = append(urls, u)
+		}
+	}
+	if len(urls) == 0 {
+		return markdown, report
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		for _, u := range urls {
+			report.Failures = append(report.Failures, Failure{URL: u, Err: err})
+		}
+		return markdown, report
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan string)
+	for range max(d.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range jobs {
+				file, err := d.save(ctx, u, dir)
+				mu.Lock()
+				if err != nil {
+					report.Failures = append(report.Failures, Failure{URL: u, Err: err})
+				} else {
+					report.Saved[u] = file
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, u := range urls {
+		jobs <- u
+	}
+	close(jobs)
+	wg.Wait()
+
+	rel, err := filepath.Rel(markdownDir, dir)
+	if err != nil {
+		rel = dir
+	}
+	markdown = imageRe.ReplaceAllStringFunc(markdown, func(match string) string {
+		m := imageRe.FindStringSubmatch(match)
+		file, ok := report.Saved[strings.Trim(m[2], "<>")]
+		if !ok {
+			return match
+		}
+		local := filepath.ToSlash(filepath.Join(rel, filepath.Base(file)))
+		if strings.ContainsAny(local, " ()") {
+			local = "<" + local + ">"
+		}
+		return "![" + m[1] + "](" + local + m[3] + ")"
+	})
+	return markdown, report
+}
+
+// save downloads one image into dir under a content-hash name
+func (d *Downloader) save(ctx context.Context, imageURL, dir string) (string, error) {
+	res, err := d.Fetcher.Download(ctx, imageURL)
+	if err != nil {
+		return "", err
+	}
+
+	mediaType := res.ContentType
+	if !strings.HasPrefix(mediaType, "image/") {
+		mediaType = http.DetectContentType(res.Body)
+	}
+	if !strings.HasPrefix(mediaType, "image/") && !strings.HasSuffix(imageURL, ".svg") {
+		return "", fmt.Errorf("not an image (%s)", mediaType)
+	}
+
+	sum := sha256.Sum256(res.Body)
+	name := hex.EncodeToString(sum[:])[:16] + extension(mediaType, res.FinalURL)
+	file := filepath.Join(dir, name)
+
+	// Identical content maps to the same name, so an existing file is done
+	if _, err := os.Stat(file); err == nil {
+		return file, nil
+	}
+	tmp, err := os.CreateTemp(dir, ".download-*")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(res.Body); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return file, nil
+}
+
+// extension picks a file extension from the media type, then the URL path
+func extension(mediaType, imageURL string) string {
+	if ext, ok := extensions[mediaType]; ok {
+		return ext
+	}
+	if u, err := url.Parse(imageURL); err == nil {
+		if ext := strings.ToLower(path.Ext(u.Path)); ext != "" && len(ext) <= 5 {
+			return ext
+		}
+	}
+	return ".img"
+}
+
+func isRemote(u string) bool {
+	return strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "https://")
+}
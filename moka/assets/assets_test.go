@@ -0,0 +1,52 @@
+package assets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"limpdev/moka/fetch"
+)
+
+// png is the 8-byte signature, enough for content sniffing
+var png = []byte("\x89PNG\r\n\x1a\n")
+
+func TestLocalize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(png)
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name   string
+		assets string
+		want   string // Pattern of the rewritten Markdown
+	}{
+		{"plain directory", "assets", `^!\[a cat\]\(assets/[0-9a-f]+\.png "Cat"\)$`},
+		{"spaced directory", "my assets", `^!\[a cat\]\(<my assets/[0-9a-f]+\.png> "Cat"\)$`},
+		{"parenthesized directory", "assets (1)", `^!\[a cat\]\(<assets \(1\)/[0-9a-f]+\.png> "Cat"\)$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := t.TempDir()
+			markdown := `![a cat](` + srv.URL + `/cat.png "Cat")`
+			got, report := New(fetch.New()).Localize(context.Background(), markdown, filepath.Join(out, tt.assets), out)
+			if len(report.Failures) > 0 {
+				t.Fatalf("failures: %v", report.Failures)
+			}
+			if !regexp.MustCompile(tt.want).MatchString(got) {
+				t.Errorf("Localize = %q, want it to match %s", got, tt.want)
+			}
+			for _, file := range report.Saved {
+				if _, err := os.Stat(file); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
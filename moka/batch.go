@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"limpdev/moka/fetch"
+	"limpdev/moka/filename"
+)
+
+// batchResult is the outcome of converting one URL
+type batchResult struct {
+	URL        string `json:"url"`
+	File       string `json:"file,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// batchSummary is printed at the end of a batch run
+type batchSummary struct {
+	Total     int           `json:"total"`
+	Succeeded int           `json:"succeeded"`
+	Failed    int           `json:"failed"`
+	Results   []batchResult `json:"results"`
+}
+
+// Batch converts every URL listed in a file (or stdin) into its own
+// Markdown file, several at a time
+func Batch(args []string) {
+	fs := flag.NewFlagSet("batch", flag.ExitOnError)
+	out := fs.String("out", ".", "Write the Markdown files into `DIR`")
+	jobs := fs.Int("jobs", 4, "Number of pages converted at the same time")
+	delay := fs.Duration("delay", time.Second, "Minimum time between requests to the same host")
+	asJSON := fs.Bool("json", false, "Print the summary as JSON")
+	full := fs.Bool("full-page", false, "Convert the whole page instead of only the main content")
+	assetsDir := fs.String("assets", "", "Download images into `DIR` and link them locally")
+	profileName := fs.String("profile", *profile, "Markdown output profile `NAME`")
+	configPath := fs.String("config", *config, "Read profiles from `FILE`")
+	sitesFile := fs.String("sites", *sitesPath, "Read site rules from `FILE`")
+	cache := fs.String("cache", *cacheDir, "Cache fetched pages in `DIR`")
+	skipCache := fs.Bool("no-cache", *noCache, "Neither read nor write the fetch cache")
+	cacheOnly := fs.Bool("offline", *offline, "Convert only from the fetch cache, without network access or delays")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka batch [flags] [URLS.txt]")
+		fmt.Fprintln(os.Stderr, "URLs are read one per line from URLS.txt, or stdin when it is omitted or -")
+		fs.PrintDefaults()
+	}
+
+	positional := parseInterleaved(fs, args)
+	if len(positional) > 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	var input io.Reader = os.Stdin
+	if len(positional) == 1 && positional[0] != "-" {
+		f, err := os.Open(positional[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		input = f
+	}
+	urls, err := readURLs(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(urls) == 0 {
+		log.Fatal("No URLs to convert")
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Fatal(err)
+	}
+
+	opts := pageOptions{
+		Profile:     loadProfile(*profileName, *configPath),
+		Sites:       loadSites(*sitesFile),
+		FullPage:    *full,
+		AssetsDir:   *assetsDir,
+		MarkdownDir: *out,
+	}
+	fetcher := newFetcher(*cache, *skipCache, *cacheOnly)
+	if fetcher.Offline() {
+		*delay = 0
+	}
+	summary := runBatch(context.Background(), fetcher, urls, *out, max(*jobs, 1), *delay, opts)
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(summary); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		for _, r := range summary.Results {
+			if r.Error != "" {
+				fmt.Printf("FAIL %s: %s\n", r.URL, r.Error)
+			} else {
+				fmt.Printf("OK   %s -> %s\n", r.URL, r.File)
+			}
+		}
+		fmt.Printf("Converted %d of %d URLs (%d failed)\n", summary.Succeeded, summary.Total, summary.Failed)
+	}
+	if summary.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// parseInterleaved parses fs allowing flags after positional arguments,
+// e.g. "batch urls.txt --jobs 8", and returns the positional arguments
+func parseInterleaved(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// readURLs reads one URL per line, skipping blank lines and # comments
+func readURLs(r io.Reader) ([]string, error) {
+	var urls []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, line)
+	}
+	return urls, scanner.Err()
+}
+
+// runBatch converts urls with a pool of workers, keeping the results in
+// input order
+func runBatch(ctx context.Context, fetcher *fetch.Fetcher, urls []string, out string, jobs int, delay time.Duration, opts pageOptions) batchSummary {
+	limiter := newHostLimiter(delay)
+	names := &nameAllocator{dir: out, used: map[string]bool{}}
+	results := make([]batchResult, len(urls))
+
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	for range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = convertOne(ctx, fetcher, limiter, names, urls[i], opts)
+			}
+		}()
+	}
+	for i := range urls {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	summary := batchSummary{Total: len(urls), Results: results}
+	for _, r := range results {
+		if r.Error != "" {
+			summary.Failed++
+		} else {
+			summary.Succeeded++
+		}
+	}
+	return summary
+}
+
+func convertOne(ctx context.Context, fetcher *fetch.Fetcher, limiter *hostLimiter, names *nameAllocator, rawURL string, opts pageOptions) (result batchResult) {
+	result.URL = rawURL
+	start := time.Now()
+	defer func() { result.DurationMS = time.Since(start).Milliseconds() }()
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		result.Error = "not an http(s) URL"
+		return result
+	}
+	if err := limiter.Wait(ctx, u.Host); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	markdown, err := convertPage(ctx, fetcher, rawURL, opts)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	file := names.Claim(rawURL)
+	if err := os.WriteFile(file, []byte(markdown), 0644); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.File = file
+	return result
+}
+
+// hostLimiter spaces out requests to the same host by at least delay, or
+// the host's own delay when one was set (e.g. from robots.txt)
+type hostLimiter struct {
+	mu     sync.Mutex
+	delay  time.Duration
+	delays map[string]time.Duration // Host -> delay overriding a shorter default
+	next   map[string]time.Time     // Host -> earliest time of the next request
+}
+
+// SetDelay raises the delay for host when d is longer than the default
+func (l *hostLimiter) SetDelay(host string, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if d > l.delay {
+		l.delays[host] = d
+	}
+}
+
+func newHostLimiter(delay time.Duration) *hostLimiter {
+	return &hostLimiter{delay: delay, delays: map[string]time.Duration{}, next: map[string]time.Time{}}
+}
+
+// Wait blocks until a request to host may be made
+func (l *hostLimiter) Wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	at := time.Now()
+	if next := l.next[host]; next.After(at) {
+		at = next
+	}
+	l.next[host] = at.Add(max(l.delay, l.delays[host]))
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// nameAllocator hands out file names in dir that no other conversion in
+// this run (or an existing file) is using
+type nameAllocator struct {
+	mu   sync.Mutex
+	dir  string
+	used map[string]bool
+}
+
+// Claim returns a free path for rawURL, adding -2, -3... when pages from the
+// same host finish within the same second
+func (a *nameAllocator) Claim(rawURL string) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	name := filename.FromURL(rawURL, time.Now())
+	stem := strings.TrimSuffix(name, ".md")
+	for n := 2; ; n++ {
+		path := filepath.Join(a.dir, name)
+		if _, err := os.Stat(path); !a.used[path] && os.IsNotExist(err) {
+			a.used[path] = true
+			return path
+		}
+		name = stem + "-" + strconv.Itoa(n) + ".md"
+	}
+}
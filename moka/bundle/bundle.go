@@ -0,0 +1,159 @@
+// Package bundle packs a converted page, its downloaded images and its
+// metadata into one TextBundle-style directory or zip archive for sharing,
+// and unpacks such archives again.
+package bundle
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"limpdev/moka/meta"
+)
+
+// The layout of a bundle
+const (
+	TextFile     = "text.md"       // The Markdown, front matter included
+	AssetsDir    = "assets"        // Images, linked from TextFile as assets/NAME
+	MetadataFile = "metadata.json" // The front matter fields as a JSON object
+	InfoFile     = "info.json"     // TextBundle descriptor, so editors can open the directory
+)
+
+// info is the TextBundle descriptor, see https://textbundle.org/spec/
+var info = map[string]any{
+	"version":           2,
+	"type":              "net.daringfireball.markdown",
+	"transient":         false,
+	"creatorIdentifier": "limpdev.moka",
+}
+
+// IsZip reports whether name asks for a zip archive rather than a directory
+func IsZip(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".zip")
+}
+
+// WriteDir writes markdown and its metadata into dir, next to the images
+// already saved in dir/assets
+func WriteDir(dir, markdown string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	fields, _ := meta.ReadFrontMatter(markdown)
+	if fields == nil {
+		fields = map[string]string{}
+	}
+	for name, v := range map[string]any{MetadataFile: fields, InfoFile: info} {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), append(data, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(dir, TextFile), []byte(markdown), 0644)
+}
+
+// Zip archives the files under dir into the zip file at name, with paths
+// relative to dir
+func Zip(dir, name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(f)
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(fi)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+		w, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		src, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(w, src)
+		return err
+	})
+	if err == nil {
+		err = zw.Close()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(name)
+	}
+	return err
+}
+
+// Extract unpacks the zip archive at name into dir, reproducing the tree the
+// bundle was made from. Entries that would land outside dir are rejected.
+func Extract(name, dir string) error {
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	for _, file := range zr.File {
+		clean := path.Clean(strings.ReplaceAll(file.Name, `\`, "/"))
+		if path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+			return fmt.Errorf("%s: entry %q is outside the bundle", name, file.Name)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(clean))
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := extractFile(file, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractFile(file *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"limpdev/moka/fetch"
+)
+
+// Cache manages the fetch cache. "clean" removes entries unused for longer
+// than --max-age, then the least recently used ones over --max-size, the same
+// limits every run applies once a day.
+func Cache(args []string) {
+	fs := flag.NewFlagSet("cache", flag.ExitOnError)
+	dir := fs.String("cache", *cacheDir, "The fetch cache `DIR`")
+	all := fs.Bool("all", false, "Remove every entry")
+	maxAge := fs.Duration("max-age", fetch.DefaultMaxAge, "Remove entries unused for longer than this (0 for no limit)")
+	maxSize := fs.Int64("max-size", fetch.DefaultMaxSize>>20, "Keep at most `MB` megabytes, removing the least recently used entries first (0 for no limit)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka cache clean [flags]")
+		fs.PrintDefaults()
+	}
+	positional := parseInterleaved(fs, args)
+	if len(positional) != 1 || positional[0] != "clean" {
+		fs.Usage()
+		os.Exit(1)
+	}
+	if *dir == "" {
+		log.Fatal("No cache directory, use --cache DIR")
+	}
+
+	c := &fetch.Cache{Dir: *dir}
+	age, size := *maxAge, *maxSize<<20
+	if *all {
+		age, size = -1, -1
+	}
+	stats, err := c.Clean(age, size)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Removed %d entries (%.1f MB), kept %d (%.1f MB) in %s\n",
+		stats.Removed, megabytes(stats.Freed), stats.Kept, megabytes(stats.Size), *dir)
+}
+
+func megabytes(n int64) float64 {
+	return float64(n) / (1 << 20)
+}
@@ -0,0 +1,264 @@
+package convert
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/JohannesKaufmann/dom"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// gutterClassRe matches the class tokens highlighters put on line numbers:
+// Pygments, Chroma, highlight.js, Prism, GitHub and others
+var gutterClassRe = regexp.MustCompile(`^(line-?numbers?(-rows)?|linenos?|lineno|linenodiv|gutter|ln|lnt|hljs-ln-numbers|hljs-ln-n|blob-num|code-line-number|react-syntax-highlighter-line-number)$`)
+
+// digitsRe matches the text of a line-number column
+var digitsRe = regexp.MustCompile(`^[\d\s]+$`)
+
+// langAttrs hold a block's language in some highlighters (Chroma, Shiki...)
+var langAttrs = []string{"data-lang", "data-language", "data-code-language", "data-highlight-language"}
+
+// ignoredLangs are class values that name no language
+var ignoredLangs = map[string]bool{
+	"": true, "none": true, "text": true, "plain": true, "plaintext": true,
+	"nohighlight": true, "no-highlight": true, "default": true, "notranslate": true,
+}
+
+// codePlugin prepares highlighted code blocks for conversion: it moves the
+// language from the highlighter's classes or attributes to a language-*
+// class the commonmark plugin reads for the fence, and drops line-number
+// gutters and copy buttons so only the code is copied.
+type codePlugin struct{}
+
+func (codePlugin) Name() string {
+	return "code-blocks"
+}
+
+func (codePlugin) Init(conv *converter.Converter) error {
+	conv.Register.PreRenderer(func(ctx converter.Context, doc *html.Node) {
+		normalizeCodeBlocks(doc)
+	}, converter.PriorityEarly)
+	return nil
+}
+
+func normalizeCodeBlocks(doc *html.Node) {
+	for _, table := range findAll(doc, atom.Table) {
+		if isCodeTable(table) {
+			unwrapCodeTable(table)
+		}
+	}
+	for _, pre := range findAll(doc, atom.Pre) {
+		for _, n := range findAllFunc(pre, func(n *html.Node) bool {
+			return n.DataAtom == atom.Button || isGutter(n)
+		}) {
+			dom.RemoveNode(n)
+		}
+		if lang := detectLanguage(pre); lang != "" {
+			setAttr(pre, "class", "language-"+lang)
+		}
+	}
+}
+
+// isCodeTable reports whether table lays out a code block next to a column
+// of line numbers, as Pygments, Chroma and highlight.js plugins do. Without a
+// highlighter's class, it must be a single row of a gutter cell and a cell
+// holding the <pre>, so data tables are left alone.
+func isCodeTable(table *html.Node) bool {
+	for _, class := range dom.GetClasses(table) {
+		switch class {
+		case "highlighttable", "lntable", "hljs-ln":
+			return true
+		}
+	}
+	rows := findAll(table, atom.Tr)
+	if len(rows) != 1 {
+		return false
+	}
+	cells := findAllFunc(rows[0], func(n *html.Node) bool { return n.DataAtom == atom.Td })
+	return len(cells) == 2 && isGutterCell(cells[0]) && dom.FindFirstNode(cells[1], func(c *html.Node) bool { return c.DataAtom == atom.Pre }) != nil
+}
+
+// unwrapCodeTable replaces a line-numbered table with its code: the <pre> of
+// the code column when there is one, otherwise a <pre> of the code cells'
+// text, one row per line
+func unwrapCodeTable(table *html.Node) {
+	var code *html.Node
+	var lines []string
+	for _, row := range findAll(table, atom.Tr) {
+		var line []string
+		for _, cell := range findAllFunc(row, func(n *html.Node) bool { return n.DataAtom == atom.Td }) {
+			if isGutterCell(cell) {
+				continue
+			}
+			if pre := dom.FindFirstNode(cell, func(c *html.Node) bool { return c.DataAtom == atom.Pre }); pre != nil && code == nil {
+				code = pre
+			}
+			line = append(line, dom.CollectText(cell))
+		}
+		lines = append(lines, strings.Join(line, ""))
+	}
+
+	text := &html.Node{Type: html.TextNode, Data: strings.Join(lines, "\n")}
+	switch {
+	case code != nil:
+		dom.RemoveNode(code)
+	case insidePre(table):
+		// highlight.js line numbers put the table inside <pre><code>
+		code = text
+	default:
+		code = &html.Node{Type: html.ElementNode, Data: "pre", DataAtom: atom.Pre}
+		code.AppendChild(text)
+	}
+	dom.ReplaceNode(table, code)
+}
+
+func insidePre(n *html.Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.DataAtom == atom.Pre {
+			return true
+		}
+	}
+	return false
+}
+
+func isGutterCell(cell *html.Node) bool {
+	if isGutter(cell) {
+		return true
+	}
+	text := dom.CollectText(cell)
+	return strings.TrimSpace(text) != "" && digitsRe.MatchString(text)
+}
+
+func isGutter(n *html.Node) bool {
+	for _, class := range dom.GetClasses(n) {
+		if gutterClassRe.MatchString(class) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectLanguage looks for the language on the block and its <code>, then
+// on the highlighter's wrapper, attributes first, then class conventions.
+// Only classes are read from further ancestors: a data-lang there is as
+// likely to be the page's locale.
+func detectLanguage(pre *html.Node) string {
+	block := []*html.Node{pre}
+	if code := dom.FindFirstNode(pre, func(c *html.Node) bool { return c.DataAtom == atom.Code }); code != nil {
+		block = append(block, code)
+	}
+	var ancestors []*html.Node
+	for n := pre.Parent; n != nil && n.Type == html.ElementNode && len(ancestors) < 3; n = n.Parent {
+		ancestors = append(ancestors, n)
+	}
+
+	withAttrs := block
+	if len(ancestors) > 0 && isHighlighterWrapper(ancestors[0]) {
+		withAttrs = append(withAttrs, ancestors[0])
+	}
+	for _, n := range withAttrs {
+		for _, key := range langAttrs {
+			if lang := normalizeLang(dom.GetAttributeOr(n, key, "")); lang != "" {
+				return lang
+			}
+		}
+	}
+	for _, n := range append(block, ancestors...) {
+		if lang := classLanguage(dom.GetAttributeOr(n, "class", "")); lang != "" {
+			return lang
+		}
+	}
+	return ""
+}
+
+// wrapperClassRe matches the classes highlighters give the element around
+// <pre>, e.g. Chroma's "highlight", Docusaurus' "codeBlockContainer"
+var wrapperClassRe = regexp.MustCompile(`(?i)highlight|code|chroma|shiki|syntax|prism|hljs|^lang(uage)?-`)
+
+// isHighlighterWrapper reports whether n is the highlighter's own wrapper
+// around a <pre>: a <figure>, as rehype-pretty-code and Shiki emit, or an
+// element with a highlighter class
+func isHighlighterWrapper(n *html.Node) bool {
+	if n.DataAtom == atom.Figure {
+		return true
+	}
+	for _, class := range dom.GetClasses(n) {
+		if wrapperClassRe.MatchString(class) {
+			return true
+		}
+	}
+	return false
+}
+
+// classLanguage reads a language from class conventions: language-go and
+// lang-go (Prism, highlight.js, Chroma), highlight-source-go (GitHub),
+// highlight-go (Sphinx), "sourceCode go" (Pandoc), "brush: go" (SyntaxHighlighter)
+// and "hljs go"
+func classLanguage(class string) string {
+	tokens := strings.Fields(class)
+	for i, t := range tokens {
+		for _, prefix := range []string{"language-", "lang-", "highlight-source-", "highlight-text-", "highlight-"} {
+			if lang, ok := strings.CutPrefix(t, prefix); ok {
+				if lang = normalizeLang(lang); lang != "" {
+					return lang
+				}
+			}
+		}
+		if (t == "sourceCode" || t == "brush:") && i+1 < len(tokens) {
+			if lang := normalizeLang(tokens[i+1]); lang != "" {
+				return lang
+			}
+		}
+		if lang, ok := strings.CutPrefix(t, "brush:"); ok {
+			if lang = normalizeLang(lang); lang != "" {
+				return lang
+			}
+		}
+	}
+	if len(tokens) == 2 && (tokens[0] == "hljs" || tokens[1] == "hljs") {
+		other := tokens[0]
+		if other == "hljs" {
+			other = tokens[1]
+		}
+		return normalizeLang(other)
+	}
+	return ""
+}
+
+func normalizeLang(lang string) string {
+	lang = strings.ToLower(strings.Trim(strings.TrimSpace(lang), ";"))
+	if ignoredLangs[lang] || strings.ContainsAny(lang, " `") {
+		return ""
+	}
+	return lang
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i, a := range n.Attr {
+		if a.Key == key {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
+
+func findAll(n *html.Node, a atom.Atom) []*html.Node {
+	return findAllFunc(n, func(c *html.Node) bool { return c.DataAtom == a })
+}
+
+// findAllFunc returns the element descendants of n matching fn, without
+// descending into matches
+func findAllFunc(n *html.Node, fn func(*html.Node) bool) []*html.Node {
+	var found []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && fn(c) {
+			found = append(found, c)
+			continue
+		}
+		found = append(found, findAllFunc(c, fn)...)
+	}
+	return found
+}
@@ -0,0 +1,75 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCodeBlocks(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "Pygments table",
+			html: `<table class="highlighttable"><tr><td class="linenos"><pre>1
+2</pre></td><td class="code"><div class="highlight"><pre><span>a</span>
+b</pre></div></td></tr></table>`,
+			want: "```\na\nb\n```",
+		},
+		{
+			name: "unclassed gutter table",
+			html: `<table><tr><td>1<br>2</td><td><pre class="language-go">x := 1
+y := 2</pre></td></tr></table>`,
+			want: "```go\nx := 1\ny := 2\n```",
+		},
+		{
+			name: "data table with a pre in another row",
+			html: `<table><tr><td>1</td><td>Widget</td></tr><tr><td>2</td><td><pre>x</pre></td></tr></table>`,
+			want: "Widget",
+		},
+		{
+			name: "data table with a pre in every row",
+			html: `<table><tr><td>1</td><td><pre>x</pre></td></tr><tr><td>2</td><td><pre>y</pre></td></tr></table>`,
+			want: "y",
+		},
+		{
+			name: "Chroma data-lang",
+			html: `<div class="highlight"><pre class="chroma"><code data-lang="rust">fn main() {}</code></pre></div>`,
+			want: "```rust\nfn main() {}\n```",
+		},
+		{
+			name: "figure data-language",
+			html: `<figure data-rehype-pretty-code-figure="" data-language="ts"><pre><code>let a = 1</code></pre></figure>`,
+			want: "```ts\nlet a = 1\n```",
+		},
+		{
+			name: "wrapper attribute",
+			html: `<div class="highlight" data-lang="python"><pre><code>print(1)</code></pre></div>`,
+			want: "```python\nprint(1)\n```",
+		},
+		{
+			name: "locale on an ancestor",
+			html: `<div data-lang="en"><div><pre><code>echo hi</code></pre></div></div>`,
+			want: "```\necho hi\n```",
+		},
+		{
+			name: "class on an ancestor",
+			html: `<div class="highlight-source-shell"><div><pre><code>echo hi</code></pre></div></div>`,
+			want: "```shell\necho hi\n```",
+		},
+	}
+	conv := builtin[DefaultProfile].Converter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.ConvertString(tt.html)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("got\n%s\nwant it to contain\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package convert
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"limpdev/moka/internal/mdtext"
+)
+
+// defListPlugin writes <dl> in the Pandoc and PHP Markdown Extra syntax, a
+// term line followed by ": definition" lines, which reads fine as plain text
+// where the syntax is not supported
+type defListPlugin struct{}
+
+func (defListPlugin) Name() string {
+	return "definition-lists"
+}
+
+func (defListPlugin) Init(conv *converter.Converter) error {
+	conv.Register.RendererFor("dl", converter.TagTypeBlock, renderDefList, converter.PriorityEarly)
+	return nil
+}
+
+func renderDefList(ctx converter.Context, w converter.Writer, n *html.Node) converter.RenderStatus {
+	var items []string
+	for _, item := range findAllFunc(n, func(c *html.Node) bool {
+		return c.DataAtom == atom.Dt || c.DataAtom == atom.Dd
+	}) {
+		var buf bytes.Buffer
+		ctx.RenderChildNodes(ctx, &buf, item)
+		content := mdtext.CollapseBlankLines(buf.String())
+		if content == "" {
+			continue
+		}
+
+		if item.DataAtom == atom.Dt {
+			// A term is a single line; a blank line separates it from the previous definition
+			term := strings.Join(strings.Fields(content), " ")
+			if len(items) > 0 {
+				term = "\n" + term
+			}
+			items = append(items, term)
+			continue
+		}
+		lines := strings.Split(content, "\n")
+		for i, line := range lines[1:] {
+			if line != "" {
+				lines[i+1] = "    " + line
+			}
+		}
+		items = append(items, ":   "+strings.Join(lines, "\n"))
+	}
+	if len(items) > 0 {
+		w.WriteString("\n\n" + strings.Join(items, "\n") + "\n\n")
+	}
+	return converter.RenderSuccess
+}
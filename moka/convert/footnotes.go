@@ -0,0 +1,207 @@
+package convert
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/JohannesKaufmann/dom"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"limpdev/moka/internal/mdtext"
+)
+
+// Placeholder elements the footnote pre-renderer leaves for the renderers,
+// holding the footnote number in data-label
+const (
+	footnoteRefTag = "moka-footnote-ref"
+	footnoteTag    = "moka-footnote"
+)
+
+var (
+	// footnoteRefRe matches the class, role or rel of footnote references
+	footnoteRefRe = regexp.MustCompile(`(?i)footnote|fnref|noteref|reference`)
+	// footnoteIDRe matches the ids of footnote bodies that are not <li>
+	footnoteIDRe = regexp.MustCompile(`(?i)^(fn|footnote|endnote|cite_note)`)
+	// backlinkRe matches the class or role of links back to the reference
+	backlinkRe = regexp.MustCompile(`(?i)back|reverse|return`)
+)
+
+// footnotePlugin turns reference/backlink footnote markup (Pandoc, kramdown,
+// markdown-it, GitHub, Wikipedia) into [^n] references and definitions at
+// the end of the document
+type footnotePlugin struct{}
+
+func (footnotePlugin) Name() string {
+	return "footnotes"
+}
+
+func (footnotePlugin) Init(conv *converter.Converter) error {
+	conv.Register.PreRenderer(func(ctx converter.Context, doc *html.Node) {
+		convertFootnotes(doc)
+	}, converter.PriorityEarly)
+	conv.Register.RendererFor(footnoteRefTag, converter.TagTypeInline, renderFootnoteRef, converter.PriorityEarly)
+	conv.Register.RendererFor(footnoteTag, converter.TagTypeBlock, renderFootnote, converter.PriorityEarly)
+	return nil
+}
+
+func convertFootnotes(doc *html.Node) {
+	ids := map[string]*html.Node{}
+	for _, n := range dom.AllNodes(doc) {
+		if id := dom.GetAttributeOr(n, "id", ""); n.Type == html.ElementNode && id != "" {
+			if _, ok := ids[id]; !ok {
+				ids[id] = n
+			}
+		}
+	}
+
+	labels := map[*html.Node]int{} // Footnote body -> number
+	var bodies []*html.Node
+	refIDs := map[string]bool{} // Ids of the references, targets of backlinks
+	for _, a := range findAll(doc, atom.A) {
+		href := dom.GetAttributeOr(a, "href", "")
+		if !strings.HasPrefix(href, "#") || len(href) < 2 {
+			continue
+		}
+		body := ids[href[1:]]
+		if body == nil || !isFootnoteBody(body) || !isFootnoteRef(a) || isBacklink(a) || body == a ||
+			dom.ContainsNode(body, func(n *html.Node) bool { return n == a }) {
+			continue
+		}
+		label, ok := labels[body]
+		if !ok {
+			label = len(bodies) + 1
+			labels[body] = label
+			bodies = append(bodies, body)
+		}
+
+		// Replace the <sup> wrapping the link too
+		ref := a
+		if p := a.Parent; p != nil && p.DataAtom == atom.Sup && onlyElementChild(p) == a {
+			ref = p
+		}
+		for _, n := range []*html.Node{a, ref} {
+			if id := dom.GetAttributeOr(n, "id", ""); id != "" {
+				refIDs[id] = true
+			}
+		}
+		dom.ReplaceNode(ref, placeholder(footnoteRefTag, "data-label", strconv.Itoa(label)))
+	}
+	if len(bodies) == 0 {
+		return
+	}
+
+	end := dom.FindFirstNode(doc, func(c *html.Node) bool { return c.DataAtom == atom.Body })
+	if end == nil {
+		end = doc
+	}
+	var containers []*html.Node
+	for _, body := range bodies {
+		// Drop the backlinks to the references
+		for _, n := range findAllFunc(body, func(n *html.Node) bool {
+			if n.DataAtom == atom.A {
+				href := dom.GetAttributeOr(n, "href", "")
+				return (strings.HasPrefix(href, "#") && refIDs[href[1:]]) || isBacklink(n)
+			}
+			return isBacklink(n)
+		}) {
+			dom.RemoveNode(n)
+		}
+
+		note := &html.Node{Type: html.ElementNode, Data: footnoteTag,
+			Attr: []html.Attribute{{Key: "data-label", Val: strconv.Itoa(labels[body])}}}
+		for c := body.FirstChild; c != nil; {
+			next := c.NextSibling
+			body.RemoveChild(c)
+			note.AppendChild(c)
+			c = next
+		}
+		if body.Parent != nil {
+			containers = append(containers, body.Parent)
+			dom.RemoveNode(body)
+		}
+		end.AppendChild(note)
+	}
+
+	// Remove the footnote lists left empty, and their wrappers
+	for _, n := range containers {
+		for n != nil && n.Parent != nil && n != end && strings.TrimSpace(dom.CollectText(n)) == "" {
+			parent := n.Parent
+			dom.RemoveNode(n)
+			n = parent
+		}
+	}
+}
+
+func isFootnoteBody(n *html.Node) bool {
+	if n.DataAtom == atom.Li {
+		return true
+	}
+	// Skip the ids of references, e.g. Pandoc's fnref1, targets of backlinks
+	id := dom.GetAttributeOr(n, "id", "")
+	return footnoteIDRe.MatchString(id) && !strings.Contains(strings.ToLower(id), "ref")
+}
+
+// isFootnoteRef reports whether a link looks like a footnote marker: short
+// text, superscript or footnote attributes
+func isFootnoteRef(a *html.Node) bool {
+	if len([]rune(strings.TrimSpace(dom.CollectText(a)))) > 6 {
+		return false
+	}
+	if (a.Parent != nil && a.Parent.DataAtom == atom.Sup) || dom.FindFirstNode(a, func(c *html.Node) bool { return c.DataAtom == atom.Sup }) != nil {
+		return true
+	}
+	if _, ok := dom.GetAttribute(a, "data-footnote-ref"); ok {
+		return true
+	}
+	return footnoteRefRe.MatchString(dom.GetAttributeOr(a, "class", "") + " " + dom.GetAttributeOr(a, "role", "") + " " + dom.GetAttributeOr(a, "rel", ""))
+}
+
+func isBacklink(n *html.Node) bool {
+	if _, ok := dom.GetAttribute(n, "data-footnote-backref"); ok {
+		return true
+	}
+	if strings.Contains(dom.GetAttributeOr(n, "class", ""), "backlink") {
+		return true
+	}
+	return n.DataAtom == atom.A && backlinkRe.MatchString(dom.GetAttributeOr(n, "class", "")+" "+dom.GetAttributeOr(n, "role", "")+" "+dom.GetAttributeOr(n, "rev", ""))
+}
+
+func renderFootnoteRef(ctx converter.Context, w converter.Writer, n *html.Node) converter.RenderStatus {
+	w.WriteString("[^" + dom.GetAttributeOr(n, "data-label", "") + "]")
+	return converter.RenderSuccess
+}
+
+// renderFootnote writes "[^n]: text", indenting any following lines
+func renderFootnote(ctx converter.Context, w converter.Writer, n *html.Node) converter.RenderStatus {
+	var buf bytes.Buffer
+	ctx.RenderChildNodes(ctx, &buf, n)
+	content := mdtext.CollapseBlankLines(buf.String())
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines[1:] {
+		if line != "" {
+			lines[i+1] = "    " + line
+		}
+	}
+	w.WriteString("\n\n[^" + dom.GetAttributeOr(n, "data-label", "") + "]: " + strings.Join(lines, "\n") + "\n\n")
+	return converter.RenderSuccess
+}
+
+// onlyElementChild returns the single element child of n, ignoring
+// whitespace, or nil
+func onlyElementChild(n *html.Node) *html.Node {
+	var only *html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.TextNode && strings.TrimSpace(c.Data) == "":
+		case c.Type == html.ElementNode && only == nil:
+			only = c
+		default:
+			return nil
+		}
+	}
+	return only
+}
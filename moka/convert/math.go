@@ -0,0 +1,151 @@
+package convert
+
+import (
+	"strings"
+
+	"github.com/JohannesKaufmann/dom"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Placeholder elements the math pre-renderer leaves for the renderers,
+// holding the TeX source in data-tex
+const (
+	mathInlineTag  = "moka-math"
+	mathDisplayTag = "moka-math-display"
+)
+
+// mathJaxOutput are the classes of MathJax 2 rendered output, dropped in
+// favour of the math/tex scripts next to them
+var mathJaxOutput = map[string]bool{
+	"MathJax": true, "MathJax_Preview": true, "MathJax_Display": true,
+	"MathJax_SVG": true, "MathJax_SVG_Display": true, "MathJax_CHTML": true,
+}
+
+// mathPlugin turns MathJax scripts, KaTeX and MathML into $…$ and $$…$$
+type mathPlugin struct{}
+
+func (mathPlugin) Name() string {
+	return "math"
+}
+
+func (mathPlugin) Init(conv *converter.Converter) error {
+	// Runs before the base plugin removes <script>, which holds MathJax 2 source
+	conv.Register.PreRenderer(func(ctx converter.Context, doc *html.Node) {
+		convertMath(doc)
+	}, converter.PriorityEarly-50)
+	conv.Register.RendererFor(mathInlineTag, converter.TagTypeInline, renderMath, converter.PriorityEarly)
+	conv.Register.RendererFor(mathDisplayTag, converter.TagTypeBlock, renderMath, converter.PriorityEarly)
+	return nil
+}
+
+func convertMath(doc *html.Node) {
+	for _, n := range findAllFunc(doc, isMath) {
+		if n.Parent == nil {
+			continue
+		}
+		tex, display := mathSource(n)
+		if tex == "" {
+			dom.RemoveNode(n)
+			continue
+		}
+		tag := mathInlineTag
+		if display {
+			tag = mathDisplayTag
+		}
+		dom.ReplaceNode(n, placeholder(tag, "data-tex", tex))
+	}
+}
+
+// isMath matches the outermost element of each formula
+func isMath(n *html.Node) bool {
+	switch {
+	case n.DataAtom == atom.Script:
+		return strings.HasPrefix(strings.ToLower(dom.GetAttributeOr(n, "type", "")), "math/tex")
+	case n.DataAtom == atom.Math, n.Data == "mjx-container":
+		return true
+	case n.DataAtom == atom.Span || n.DataAtom == atom.Div:
+		for _, class := range dom.GetClasses(n) {
+			switch {
+			case class == "katex-display", class == "katex", class == "mwe-math-element", mathJaxOutput[class]:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mathSource returns the TeX of a formula element and whether it is display
+// math. MathJax 2 output yields no source, so it is removed.
+func mathSource(n *html.Node) (string, bool) {
+	class := " " + dom.GetAttributeOr(n, "class", "") + " "
+	switch {
+	case n.DataAtom == atom.Script:
+		return strings.TrimSpace(dom.CollectText(n)), strings.Contains(dom.GetAttributeOr(n, "type", ""), "mode=display")
+	case strings.Contains(class, " katex-display "):
+		tex, _ := mathSource(firstMath(n))
+		return tex, true
+	case n.Data == "mjx-container":
+		tex, _ := mathSource(firstMath(n))
+		return tex, dom.GetAttributeOr(n, "display", "") == "true" || dom.GetAttributeOr(n, "display", "") == "block"
+	case strings.Contains(class, " mwe-math-element "):
+		tex, display := mathSource(firstMath(n))
+		display = display || len(findAllFunc(n, func(c *html.Node) bool {
+			return strings.Contains(dom.GetAttributeOr(c, "class", ""), "mwe-math-mathml-display")
+		})) > 0
+		return tex, display
+	case n.DataAtom == atom.Math:
+		display := dom.GetAttributeOr(n, "display", "") == "block"
+		for _, a := range findAll(n, atom.Annotation) {
+			if strings.EqualFold(dom.GetAttributeOr(a, "encoding", ""), "application/x-tex") {
+				return stripDisplayStyle(dom.CollectText(a)), display
+			}
+		}
+		if alt := dom.GetAttributeOr(n, "alttext", ""); alt != "" {
+			return stripDisplayStyle(alt), display
+		}
+		return strings.Join(strings.Fields(dom.CollectText(n)), " "), display
+	case strings.Contains(class, " katex "):
+		return mathSource(firstMath(n))
+	}
+	return "", false
+}
+
+// firstMath returns the MathML inside a rendered formula, or an empty node
+func firstMath(n *html.Node) *html.Node {
+	if m := dom.FindFirstNode(n, func(c *html.Node) bool { return c.DataAtom == atom.Math }); m != nil {
+		return m
+	}
+	return &html.Node{Type: html.ElementNode}
+}
+
+// stripDisplayStyle unwraps Wikipedia's "{\displaystyle …}" around its TeX
+func stripDisplayStyle(tex string) string {
+	tex = strings.TrimSpace(tex)
+	for _, prefix := range []string{`{\displaystyle `, `{\textstyle `} {
+		if inner, ok := strings.CutPrefix(tex, prefix); ok && strings.HasSuffix(inner, "}") {
+			return strings.TrimSpace(strings.TrimSuffix(inner, "}"))
+		}
+	}
+	return tex
+}
+
+// placeholder returns an element carrying val in the attribute key. It holds
+// val as text too, so whitespace collapsing treats it like the text it
+// replaces and keeps the spaces around it.
+func placeholder(tag, key, val string) *html.Node {
+	n := &html.Node{Type: html.ElementNode, Data: tag, Attr: []html.Attribute{{Key: key, Val: val}}}
+	n.AppendChild(&html.Node{Type: html.TextNode, Data: val})
+	return n
+}
+
+func renderMath(ctx converter.Context, w converter.Writer, n *html.Node) converter.RenderStatus {
+	tex := dom.GetAttributeOr(n, "data-tex", "")
+	if n.Data == mathDisplayTag {
+		w.WriteString("\n\n$$\n" + tex + "\n$$\n\n")
+	} else {
+		w.WriteString("$" + tex + "$")
+	}
+	return converter.RenderSuccess
+}
@@ -0,0 +1,96 @@
+package convert
+
+import (
+	"cmp"
+	"log"
+	"net/url"
+
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"limpdev/moka/extract"
+	"limpdev/moka/fetch"
+	"limpdev/moka/links"
+	"limpdev/moka/meta"
+	"limpdev/moka/sites"
+)
+
+// PageOptions control how a fetched page is converted
+type PageOptions struct {
+	Profile  Profile
+	Sites    *sites.Registry // Site-specific rules, matched on the page's host
+	FullPage bool            // Skip main content extraction
+	BaseURL  string          // Resolve relative links against this instead of the page URL
+}
+
+// Page converts a fetched page to Markdown: it resolves relative links,
+// applies the site rule for the page's host or extracts the main content,
+// and converts the result with the profile. It returns the Markdown without
+// front matter and the metadata for it; source is the URL or path the page
+// was requested as.
+func Page(source string, page *fetch.Result, opts PageOptions) (string, meta.Metadata, error) {
+	content := string(page.Body)
+	md := pageMetadata(source, page)
+	base := cmp.Or(opts.BaseURL, page.FinalURL)
+	if base != "" {
+		if resolved, err := links.Resolve(content, base); err != nil {
+			log.Printf("Could not resolve relative links in %s: %s", source, err)
+		} else {
+			content = resolved
+		}
+	}
+
+	// A site rule strips its selectors and, when it finds the content root,
+	// takes the place of the generic extraction
+	var plugins []converter.Plugin
+	siteContent := ""
+	if rule := opts.Sites.Match(hostname(base)); rule != nil {
+		cleaned, root, err := rule.Apply(content)
+		if err != nil {
+			log.Printf("Site rule %q failed for %s: %s", rule.Name, source, err)
+		} else {
+			content, siteContent = cleaned, root
+			plugins = append(plugins, rule.Plugin())
+		}
+	}
+
+	switch {
+	case opts.FullPage:
+	case siteContent != "":
+		article := &extract.Article{Title: md.Title, Byline: md.Author, Published: md.Published, Content: siteContent}
+		content = article.HTML()
+	default:
+		article, err := extract.Extract(content)
+		if err != nil {
+			log.Printf("Main content extraction failed for %s, converting the full page: %s", source, err)
+		} else {
+			content = article.HTML()
+			md.Title = cmp.Or(md.Title, article.Title)
+			md.Author = cmp.Or(md.Author, article.Byline)
+			md.Published = cmp.Or(md.Published, article.Published)
+		}
+	}
+
+	markdown, err := opts.Profile.Converter(plugins...).ConvertString(content)
+	if err != nil {
+		return "", md, err
+	}
+	return markdown, md, nil
+}
+
+// pageMetadata collects the front matter fields for a fetched page
+func pageMetadata(source string, page *fetch.Result) meta.Metadata {
+	md := meta.Parse(string(page.Body))
+	md.Source = source
+	md.FinalURL = page.FinalURL
+	md.Fetched = page.Fetched
+	md.Language = cmp.Or(md.Language, page.Header.Get("Content-Language"))
+	return md
+}
+
+// hostname returns the host of rawURL without port, or "" when it does not parse
+func hostname(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
@@ -0,0 +1,37 @@
+package convert
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"limpdev/moka/fetch"
+)
+
+func TestPagePublishedFromArticle(t *testing.T) {
+	// No OpenGraph, article:published_time or JSON-LD date: only the byline has one
+	body := `<html><head><title>Post</title></head><body>
+<nav><a href="/">Home</a> <a href="/about">About</a></nav>
+<article>
+<h1>Post</h1>
+<p class="byline">By Ada, <time datetime="2024-05-01T09:00:00Z">May 1, 2024</time></p>
+<p>` + strings.Repeat("The main content of the post goes on, and on, for long enough to be extracted. ", 8) + `</p>
+<p>` + strings.Repeat("A second paragraph keeps the article well ahead of the navigation, with commas, too. ", 8) + `</p>
+</article>
+</body></html>`
+	page := &fetch.Result{
+		URL:      "https://example.com/post",
+		FinalURL: "https://example.com/post",
+		Header:   http.Header{},
+		Body:     []byte(body),
+		Fetched:  time.Now(),
+	}
+	_, md, err := Page(page.URL, page, PageOptions{Profile: builtin[DefaultProfile]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md.Published != "2024-05-01T09:00:00Z" {
+		t.Errorf("Published = %q, want the date from the article", md.Published)
+	}
+}
@@ -0,0 +1,276 @@
+// Package convert builds the HTML to Markdown converter from named profiles,
+// so the CLI and the GUI produce the same Markdown for the same settings.
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/base"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/commonmark"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/strikethrough"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/table"
+)
+
+// DefaultProfile is used when no profile is selected
+const DefaultProfile = "default"
+
+// Profile is a set of Markdown output options
+type Profile struct {
+	Name             string `json:"-"`
+	EmDelimiter      string `json:"em_delimiter"`       // "*" or "_"
+	StrongDelimiter  string `json:"strong_delimiter"`   // "**" or "__"
+	HorizontalRule   string `json:"horizontal_rule"`    // e.g. "---" or "* * *"
+	BulletListMarker string `json:"bullet_list_marker"` // "-", "*" or "+"
+	CodeBlockFence   string `json:"code_block_fence"`   // "```" or "~~~"
+	HeadingStyle     string `json:"heading_style"`      // "atx" or "setext"
+	EmptyLinks       string `json:"empty_links"`        // "skip" or "render" links without text or href
+	Strikethrough    bool   `json:"strikethrough"`      // Convert <del>/<s> to ~~text~~
+	Tables           bool   `json:"tables"`             // Convert <table> to pipe tables
+	HeaderPromotion  bool   `json:"header_promotion"`   // Use the first row as header when there is no <th>
+	SkipEmptyRows    bool   `json:"skip_empty_rows"`
+	SpanCells        string `json:"span_cells"` // "empty" or "mirror" cells covered by colspan/rowspan
+}
+
+// builtin are the profiles available without a config file
+var builtin = map[string]Profile{
+	"default": {
+		EmDelimiter:      "*",
+		StrongDelimiter:  "**",
+		HorizontalRule:   "* * *",
+		BulletListMarker: "-",
+		CodeBlockFence:   "```",
+		HeadingStyle:     "atx",
+		EmptyLinks:       "skip",
+		Tables:           true,
+		HeaderPromotion:  true,
+		SkipEmptyRows:    true,
+		SpanCells:        "empty",
+	},
+	"github": {
+		EmDelimiter:      "_",
+		StrongDelimiter:  "**",
+		HorizontalRule:   "---",
+		BulletListMarker: "-",
+		CodeBlockFence:   "```",
+		HeadingStyle:     "atx",
+		EmptyLinks:       "skip",
+		Strikethrough:    true,
+		Tables:           true,
+		HeaderPromotion:  true,
+		SkipEmptyRows:    true,
+		SpanCells:        "mirror",
+	},
+	"obsidian": {
+		EmDelimiter:      "*",
+		StrongDelimiter:  "**",
+		HorizontalRule:   "---",
+		BulletListMarker: "-",
+		CodeBlockFence:   "```",
+		HeadingStyle:     "atx",
+		EmptyLinks:       "skip",
+		Strikethrough:    true,
+		Tables:           true,
+		HeaderPromotion:  true,
+		SkipEmptyRows:    true,
+		SpanCells:        "empty",
+	},
+	"plain": {
+		EmDelimiter:      "_",
+		StrongDelimiter:  "**",
+		HorizontalRule:   "* * *",
+		BulletListMarker: "*",
+		CodeBlockFence:   "```",
+		HeadingStyle:     "setext",
+		EmptyLinks:       "skip",
+		Tables:           true,
+		HeaderPromotion:  true,
+		SpanCells:        "empty",
+	},
+}
+
+// DefaultConfigPath is where profiles are read from when no path is given,
+// e.g. ~/.config/moka/profiles.json
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "moka", "profiles.json")
+}
+
+// Load returns the built-in profiles merged with those in the config file at
+// path, or at DefaultConfigPath when path is empty. A missing default config
+// is not an error. The file looks like:
+//
+//	{"profiles": {"notes": {"extends": "obsidian", "bullet_list_marker": "*"}}}
+//
+// Profiles start as a copy of the profile they extend ("default" when
+// omitted) and override the fields they set.
+func Load(path string) (map[string]Profile, error) {
+	profiles := map[string]Profile{}
+	for name, p := range builtin {
+		p.Name = name
+		profiles[name] = p
+	}
+
+	explicit := path != ""
+	if !explicit {
+		path = DefaultConfigPath()
+	}
+	if path == "" {
+		return profiles, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var config struct {
+		Profiles map[string]json.RawMessage `json:"profiles"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	// Resolve in dependency order so a profile may extend another custom one
+	var resolve func(name string, seen []string) error
+	resolve = func(name string, seen []string) error {
+		raw, ok := config.Profiles[name]
+		if !ok || slices.Contains(seen, name) {
+			return nil
+		}
+		var header struct {
+			Extends string `json:"extends"`
+		}
+		if err := json.Unmarshal(raw, &header); err != nil {
+			return fmt.Errorf("%s: profile %q: %w", path, name, err)
+		}
+		parent := header.Extends
+		if parent == "" {
+			parent = DefaultProfile
+		}
+		if parent == name {
+			return fmt.Errorf("%s: profile %q extends itself", path, name)
+		}
+		if slices.Contains(seen, parent) {
+			return fmt.Errorf("%s: profile %q has an extends cycle", path, name)
+		}
+		if err := resolve(parent, append(seen, name)); err != nil {
+			return err
+		}
+		p, ok := profiles[parent]
+		if !ok {
+			return fmt.Errorf("%s: profile %q extends unknown profile %q", path, name, parent)
+		}
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return fmt.Errorf("%s: profile %q: %w", path, name, err)
+		}
+		p.Name = name
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		profiles[name] = p
+		delete(config.Profiles, name)
+		return nil
+	}
+	for name := range config.Profiles {
+		if err := resolve(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return profiles, nil
+}
+
+// Lookup loads the profiles from the config at path and returns the named one
+func Lookup(name, path string) (Profile, error) {
+	profiles, err := Load(path)
+	if err != nil {
+		return Profile{}, err
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	p, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		return Profile{}, fmt.Errorf("unknown profile %q (available: %s)", name, strings.Join(names, ", "))
+	}
+	return p, nil
+}
+
+// Validate checks the enumerated options
+func (p Profile) Validate() error {
+	for _, check := range []struct {
+		option, value string
+		allowed       []string
+	}{
+		{"em_delimiter", p.EmDelimiter, []string{"*", "_"}},
+		{"strong_delimiter", p.StrongDelimiter, []string{"**", "__"}},
+		{"bullet_list_marker", p.BulletListMarker, []string{"-", "*", "+"}},
+		{"code_block_fence", p.CodeBlockFence, []string{"```", "~~~"}},
+		{"heading_style", p.HeadingStyle, []string{"atx", "setext"}},
+		{"empty_links", p.EmptyLinks, []string{"skip", "render"}},
+		{"span_cells", p.SpanCells, []string{"empty", "mirror"}},
+	} {
+		if !slices.Contains(check.allowed, check.value) {
+			return fmt.Errorf("profile %q: %s must be one of %s, not %q",
+				p.Name, check.option, strings.Join(check.allowed, " "), check.value)
+		}
+	}
+	return nil
+}
+
+// Converter returns an HTML to Markdown converter configured by p, with any
+// extra plugins (e.g. site-specific renderers) registered last
+func (p Profile) Converter(extra ...converter.Plugin) *converter.Converter {
+	// The option types are unexported, so pick from the package constants
+	heading := commonmark.WithHeadingStyle(commonmark.HeadingStyleATX)
+	if p.HeadingStyle == "setext" {
+		heading = commonmark.WithHeadingStyle(commonmark.HeadingStyleSetext)
+	}
+	emptyLinks := commonmark.LinkBehaviorSkip
+	if p.EmptyLinks == "render" {
+		emptyLinks = commonmark.LinkBehaviorRender
+	}
+
+	plugins := []converter.Plugin{
+		base.NewBasePlugin(),
+		commonmark.NewCommonmarkPlugin(
+			commonmark.WithEmDelimiter(p.EmDelimiter),
+			commonmark.WithStrongDelimiter(p.StrongDelimiter),
+			commonmark.WithHorizontalRule(p.HorizontalRule),
+			commonmark.WithBulletListMarker(p.BulletListMarker),
+			commonmark.WithCodeBlockFence(p.CodeBlockFence),
+			heading,
+			commonmark.WithLinkEmptyContentBehavior(emptyLinks),
+			commonmark.WithLinkEmptyHrefBehavior(emptyLinks),
+		),
+	}
+	plugins = append(plugins, codePlugin{}, mathPlugin{}, footnotePlugin{}, defListPlugin{})
+	if p.Strikethrough {
+		plugins = append(plugins, strikethrough.NewStrikethroughPlugin())
+	}
+	if p.Tables {
+		plugins = append(plugins, table.NewTablePlugin(
+			table.WithHeaderPromotion(p.HeaderPromotion),
+			table.WithSkipEmptyRows(p.SkipEmptyRows),
+			table.WithSpanCellBehavior(table.SpanCellBehavior(p.SpanCells)),
+		))
+	}
+	plugins = append(plugins, extra...)
+	return converter.NewConverter(converter.WithPlugins(plugins...))
+}
@@ -0,0 +1,427 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"limpdev/moka/fetch"
+	"limpdev/moka/links"
+	"limpdev/moka/robots"
+)
+
+// robotsAgent is the user agent matched against robots.txt groups
+const robotsAgent = "moka"
+
+// skippedExts are link targets that are never HTML pages
+var skippedExts = map[string]bool{
+	".7z": true, ".css": true, ".dmg": true, ".exe": true, ".gif": true, ".gz": true,
+	".ico": true, ".jpeg": true, ".jpg": true, ".js": true, ".json": true, ".mp3": true,
+	".mp4": true, ".pdf": true, ".png": true, ".svg": true, ".tar": true, ".tgz": true,
+	".webm": true, ".webp": true, ".woff": true, ".woff2": true, ".xml": true, ".zip": true,
+}
+
+// markdownLinkRe matches the target of a Markdown link or image: ](url "title")
+var markdownLinkRe = regexp.MustCompile(`\]\((<[^>]*>|[^)\s]+)((?:\s+"[^"]*")?)\)`)
+
+// errSkipped marks pages that were not converted on purpose
+var errSkipped = errors.New("skipped")
+
+// crawledPage is the outcome of visiting one URL
+type crawledPage struct {
+	URL      string // Normalized URL that was requested
+	FinalURL string // Normalized URL after redirects
+	File     string // Path of the Markdown file, relative to the output directory
+	Markdown string
+	Links    []string
+	Err      error
+}
+
+// crawler mirrors a site breadth-first into a tree of Markdown files
+type crawler struct {
+	fetcher  *fetch.Fetcher
+	limiter  *hostLimiter
+	out      string
+	opts     pageOptions
+	start    *url.URL
+	sameHost bool
+	include  *regexp.Regexp
+	exclude  *regexp.Regexp
+
+	mu     sync.Mutex
+	robots map[string]*robotsEntry // scheme://host -> rules
+}
+
+type robotsEntry struct {
+	once  sync.Once
+	rules *robots.Rules
+}
+
+// Crawl follows links from a start URL and writes one Markdown file per page
+// in a directory tree mirroring the URL paths
+func Crawl(args []string) {
+	fs := flag.NewFlagSet("crawl", flag.ExitOnError)
+	depth := fs.Int("depth", 2, "Follow links up to `N` clicks away from the start page")
+	sameHost := fs.Bool("same-host", false, "Only follow links to the start page's host")
+	include := fs.String("include", "", "Only follow URLs matching `REGEX`")
+	exclude := fs.String("exclude", "", "Never follow URLs matching `REGEX`")
+	out := fs.String("out", ".", "Write the Markdown tree into `DIR`")
+	jobs := fs.Int("jobs", 4, "Number of pages converted at the same time")
+	delay := fs.Duration("delay", time.Second, "Minimum time between requests to the same host")
+	maxPages := fs.Int("max-pages", 500, "Stop following links after `N` pages")
+	full := fs.Bool("full-page", false, "Convert the whole page instead of only the main content")
+	assetsDir := fs.String("assets", "", "Download images into `DIR` and link them locally")
+	profileName := fs.String("profile", *profile, "Markdown output profile `NAME`")
+	configPath := fs.String("config", *config, "Read profiles from `FILE`")
+	sitesFile := fs.String("sites", *sitesPath, "Read site rules from `FILE`")
+	cache := fs.String("cache", *cacheDir, "Cache fetched pages in `DIR`")
+	skipCache := fs.Bool("no-cache", *noCache, "Neither read nor write the fetch cache")
+	cacheOnly := fs.Bool("offline", *offline, "Convert only from the fetch cache, without network access or delays")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka crawl [flags] <URL>")
+		fs.PrintDefaults()
+	}
+
+	positional := parseInterleaved(fs, args)
+	if len(positional) != 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+	start, _, ok := normalizeURL(positional[0])
+	if !ok {
+		log.Fatalf("Not an http(s) URL: %s", positional[0])
+	}
+
+	fetcher := newFetcher(*cache, *skipCache, *cacheOnly)
+	if fetcher.Offline() {
+		*delay = 0
+	}
+	c := &crawler{
+		fetcher: fetcher,
+		limiter: newHostLimiter(*delay),
+		out:     *out,
+		opts: pageOptions{
+			Profile:   loadProfile(*profileName, *configPath),
+			Sites:     loadSites(*sitesFile),
+			FullPage:  *full,
+			AssetsDir: *assetsDir,
+		},
+		start:    start,
+		sameHost: *sameHost,
+		robots:   map[string]*robotsEntry{},
+	}
+	var err error
+	if *include != "" {
+		if c.include, err = regexp.Compile(*include); err != nil {
+			log.Fatalf("Invalid --include: %s", err)
+		}
+	}
+	if *exclude != "" {
+		if c.exclude, err = regexp.Compile(*exclude); err != nil {
+			log.Fatalf("Invalid --exclude: %s", err)
+		}
+	}
+
+	pages := c.crawl(context.Background(), max(*depth, 0), max(*jobs, 1), max(*maxPages, 1))
+
+	saved, failed, skipped := 0, 0, 0
+	for _, p := range pages {
+		switch {
+		case errors.Is(p.Err, errSkipped):
+			skipped++
+			fmt.Printf("SKIP %s: %s\n", p.URL, p.Err)
+		case p.Err != nil:
+			failed++
+			fmt.Printf("FAIL %s: %s\n", p.URL, p.Err)
+		default:
+			saved++
+			fmt.Printf("OK   %s -> %s\n", p.URL, filepath.Join(c.out, p.File))
+		}
+	}
+	fmt.Printf("Crawled %d pages into %s (%d failed, %d skipped)\n", saved, c.out, failed, skipped)
+	if saved == 0 {
+		os.Exit(1)
+	}
+}
+
+// crawl visits pages level by level, then rewrites the links between saved
+// pages and writes them out
+func (c *crawler) crawl(ctx context.Context, maxDepth, jobs, maxPages int) []*crawledPage {
+	startKey := c.start.String()
+	seen := map[string]bool{startKey: true}
+	level := []string{startKey}
+	var visited []*crawledPage
+
+	for depth := 0; len(level) > 0 && depth <= maxDepth; depth++ {
+		results := c.visitAll(ctx, level, jobs)
+		visited = append(visited, results...)
+		if depth == maxDepth {
+			break
+		}
+
+		level = nil
+		for _, p := range results {
+			for _, link := range p.Links {
+				u, key, ok := normalizeURL(link)
+				if !ok || seen[key] || len(seen) >= maxPages || !c.inScope(u) {
+					continue
+				}
+				seen[key] = true
+				level = append(level, key)
+			}
+		}
+	}
+
+	// /a and /a.html share a local path; the later pages get -2, -3... so
+	// none overwrites another
+	var saved []*crawledPage
+	var names []string
+	for _, p := range visited {
+		if p.Err == nil {
+			saved = append(saved, p)
+			names = append(names, p.File)
+		}
+	}
+	for i, name := range uniqueFiles(names) {
+		saved[i].File = name
+	}
+
+	// Map every URL a saved page answers to onto its file
+	files := map[string]string{}
+	for _, p := range visited {
+		if p.Err == nil {
+			files[p.URL] = p.File
+			if _, ok := files[p.FinalURL]; !ok {
+				files[p.FinalURL] = p.File
+			}
+		}
+	}
+	for _, p := range visited {
+		if p.Err != nil {
+			continue
+		}
+		markdown := rewriteLinks(p.Markdown, p.File, files)
+		file := filepath.Join(c.out, p.File)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			p.Err = err
+			continue
+		}
+		if err := os.WriteFile(file, []byte(markdown), 0644); err != nil {
+			p.Err = err
+		}
+	}
+	return visited
+}
+
+// visitAll fetches and converts urls with a pool of workers
+func (c *crawler) visitAll(ctx context.Context, urls []string, jobs int) []*crawledPage {
+	results := make([]*crawledPage, len(urls))
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	for range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = c.visit(ctx, urls[i])
+				log.Printf("Visited %s", urls[i])
+			}
+		}()
+	}
+	for i := range urls {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+func (c *crawler) visit(ctx context.Context, rawURL string) *crawledPage {
+	p := &crawledPage{URL: rawURL, FinalURL: rawURL}
+	u, _, _ := normalizeURL(rawURL)
+	p.File = localPath(u)
+
+	if !c.rulesFor(ctx, u).Allowed(robotsPath(u)) {
+		p.Err = fmt.Errorf("%w: disallowed by robots.txt", errSkipped)
+		return p
+	}
+	if err := c.limiter.Wait(ctx, u.Host); err != nil {
+		p.Err = err
+		return p
+	}
+	page, err := c.fetcher.Fetch(ctx, rawURL)
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	if page.ContentType != "" && page.ContentType != "text/html" && page.ContentType != "application/xhtml+xml" {
+		p.Err = fmt.Errorf("%w: not HTML (%s)", errSkipped, page.ContentType)
+		return p
+	}
+	if _, key, ok := normalizeURL(page.FinalURL); ok {
+		p.FinalURL = key
+	}
+
+	if p.Links, err = links.Collect(string(page.Body), page.FinalURL); err != nil {
+		log.Printf("Could not collect links from %s: %s", rawURL, err)
+	}
+	opts := c.opts
+	opts.MarkdownDir = filepath.Join(c.out, filepath.Dir(p.File))
+	p.Markdown, p.Err = convertFetched(ctx, c.fetcher, rawURL, page, opts)
+	return p
+}
+
+// inScope reports whether a link found on a page should be followed
+func (c *crawler) inScope(u *url.URL) bool {
+	if c.sameHost && u.Host != c.start.Host {
+		return false
+	}
+	if skippedExts[strings.ToLower(path.Ext(u.Path))] {
+		return false
+	}
+	if c.include != nil && !c.include.MatchString(u.String()) {
+		return false
+	}
+	return c.exclude == nil || !c.exclude.MatchString(u.String())
+}
+
+// rulesFor fetches robots.txt once per host. A missing file allows
+// everything; a server error disallows everything.
+func (c *crawler) rulesFor(ctx context.Context, u *url.URL) *robots.Rules {
+	origin := u.Scheme + "://" + u.Host
+	c.mu.Lock()
+	entry, ok := c.robots[origin]
+	if !ok {
+		entry = &robotsEntry{}
+		c.robots[origin] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		res, err := c.fetcher.Download(ctx, origin+"/robots.txt")
+		var statusErr *fetch.StatusError
+		switch {
+		case err == nil:
+			entry.rules = robots.Parse(strings.NewReader(string(res.Body)), robotsAgent)
+			if !c.fetcher.Offline() {
+				c.limiter.SetDelay(u.Host, entry.rules.CrawlDelay)
+			}
+		case errors.As(err, &statusErr) && statusErr.StatusCode < 500:
+			entry.rules = robots.AllowAll
+		default:
+			log.Printf("Could not fetch robots.txt for %s, skipping the host: %s", origin, err)
+			entry.rules = robots.DisallowAll
+		}
+	})
+	return entry.rules
+}
+
+// normalizeURL parses an http(s) URL and returns it without fragment and with
+// a lowercase host, along with its string form used as the page's identity
+func normalizeURL(rawURL string) (*url.URL, string, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, "", false
+	}
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment, u.RawFragment = "", ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u, u.String(), true
+}
+
+// robotsPath is the path and query matched against robots.txt rules
+func robotsPath(u *url.URL) string {
+	if u.RawQuery != "" {
+		return u.EscapedPath() + "?" + u.RawQuery
+	}
+	return u.EscapedPath()
+}
+
+// localPath maps a URL to a Markdown file under a directory named after its
+// host: /docs/ -> host/docs/index.md, /docs/intro.html -> host/docs/intro.md.
+// Query strings get a short hash so /list?page=2 does not overwrite /list.
+func localPath(u *url.URL) string {
+	p := path.Clean("/" + u.Path)
+	if strings.HasSuffix(u.Path, "/") {
+		p = path.Join(p, "index")
+	}
+	switch strings.ToLower(path.Ext(p)) {
+	case ".html", ".htm", ".xhtml", ".php", ".asp", ".aspx", ".jsp", ".md":
+		p = strings.TrimSuffix(p, path.Ext(p))
+	}
+	if u.RawQuery != "" {
+		h := fnv.New32a()
+		h.Write([]byte(u.RawQuery))
+		p += fmt.Sprintf("-%08x", h.Sum32())
+	}
+	host := strings.ReplaceAll(u.Host, ":", "-")
+	return filepath.Join(host, filepath.FromSlash(strings.TrimPrefix(p, "/")+".md"))
+}
+
+// uniqueFiles returns files with a -2, -3... suffix on every repeat of an
+// earlier path, avoiding the paths already in the list. Paths are compared
+// ignoring case, for case-insensitive file systems.
+func uniqueFiles(files []string) []string {
+	taken := map[string]bool{}
+	for _, f := range files {
+		taken[strings.ToLower(f)] = true
+	}
+	claimed := map[string]bool{}
+	unique := make([]string, len(files))
+	for i, f := range files {
+		name := f
+		if claimed[strings.ToLower(f)] {
+			stem := strings.TrimSuffix(f, ".md")
+			for n := 2; taken[strings.ToLower(name)]; n++ {
+				name = fmt.Sprintf("%s-%d.md", stem, n)
+			}
+			taken[strings.ToLower(name)] = true
+		}
+		claimed[strings.ToLower(name)] = true
+		unique[i] = name
+	}
+	return unique
+}
+
+// rewriteLinks points links to crawled pages at their local files, keeping
+// the fragment, relative to the file the Markdown is written to
+func rewriteLinks(markdown, fromFile string, files map[string]string) string {
+	fromDir := filepath.Dir(fromFile)
+	return markdownLinkRe.ReplaceAllStringFunc(markdown, func(match string) string {
+		m := markdownLinkRe.FindStringSubmatch(match)
+		link := strings.Trim(m[1], "<>")
+		_, key, ok := normalizeURL(link)
+		if !ok {
+			return match
+		}
+		target, ok := files[key]
+		if !ok {
+			return match
+		}
+		rel, err := filepath.Rel(fromDir, target)
+		if err != nil {
+			return match
+		}
+		local := filepath.ToSlash(rel)
+		if _, fragment, found := strings.Cut(link, "#"); found {
+			local += "#" + fragment
+		}
+		if strings.ContainsAny(local, " ()") {
+			local = "<" + local + ">"
+		}
+		return "](" + local + m[2] + ")"
+	})
+}
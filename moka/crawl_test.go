@@ -0,0 +1,34 @@
+package main
+
+import (
+	"net/url"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestLocalPathCollisions(t *testing.T) {
+	var files []string
+	for _, rawURL := range []string{
+		"https://example.com/a",
+		"https://example.com/a.html",
+		"https://example.com/a-2",
+		"https://example.com/A.htm",
+		"https://example.com/b/",
+	} {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, localPath(u))
+	}
+
+	got := uniqueFiles(files)
+	want := []string{"a.md", "a-3.md", "a-2.md", "A-4.md", "b/index.md"}
+	for i := range want {
+		want[i] = filepath.Join("example.com", filepath.FromSlash(want[i]))
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("uniqueFiles(%q) = %q, want %q", files, got, want)
+	}
+}
@@ -0,0 +1,215 @@
+// Package diff compares two texts line by line and formats the changes as a
+// unified diff, as diff -u and git do.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultContext is the number of unchanged lines shown around each change
+const DefaultContext = 3
+
+// opKind says what happened to a line
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+// op is one line of the edit script, with its index in the old and new text
+type op struct {
+	kind opKind
+	a, b int
+}
+
+// Unified returns the unified diff turning a into b, labelled with the file
+// names oldName and newName, or "" when the texts are equal. Each hunk shows
+// up to context unchanged lines around the changes.
+func Unified(oldName, newName, a, b string, context int) string {
+	if a == b {
+		return ""
+	}
+	linesA, linesB := splitLines(a), splitLines(b)
+	ops := script(linesA, linesB)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+	for _, h := range hunks(ops, context) {
+		writeHunk(&sb, h, linesA, linesB)
+	}
+	return sb.String()
+}
+
+// splitLines splits text into lines keeping their "\n", so a missing final
+// newline shows up as a change
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// script returns a shortest edit script from a to b. The common prefix and
+// suffix are matched directly; the middle is diffed with Myers' algorithm.
+func script(a, b []string) []op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []op
+	for i := range prefix {
+		ops = append(ops, op{opEqual, i, i})
+	}
+	for _, o := range myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]) {
+		ops = append(ops, op{o.kind, o.a + prefix, o.b + prefix})
+	}
+	for i := range suffix {
+		ops = append(ops, op{opEqual, len(a) - suffix + i, len(b) - suffix + i})
+	}
+	return ops
+}
+
+// myers finds the shortest edit script with the greedy algorithm from
+// "An O(ND) Difference Algorithm and Its Variations", keeping the furthest
+// reaching paths of every step to walk the script back
+func myers(a, b []string) []op {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+search:
+	for d := 0; d <= n+m; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // Down: insert from b
+			} else {
+				x = v[offset+k-1] + 1 // Right: delete from a
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+				break search
+			}
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+	}
+
+	// Walk back from (n, m); trace[d] holds v[-d..d] after step d
+	var ops []op
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d-1] // Indexed by k + d - 1
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && prev[k-1+d-1] < prev[k+1+d-1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := prev[prevK+d-1]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			ops = append(ops, op{opEqual, x, y})
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, op{opInsert, x, y})
+		} else {
+			x--
+			ops = append(ops, op{opDelete, x, y})
+		}
+	}
+	for x > 0 && y > 0 {
+		x, y = x-1, y-1
+		ops = append(ops, op{opEqual, x, y})
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// hunks groups the edit script into runs of changes with context lines
+// around them, merging runs whose context would touch or overlap
+func hunks(ops []op, context int) [][]op {
+	var result [][]op
+	start, end := -1, -1 // Range of ops in the current hunk
+	for i, o := range ops {
+		if o.kind == opEqual {
+			continue
+		}
+		lo, hi := max(i-context, 0), min(i+context+1, len(ops))
+		if start >= 0 && lo > end {
+			result = append(result, ops[start:end])
+			start = -1
+		}
+		if start < 0 {
+			start = lo
+		}
+		end = hi
+	}
+	if start >= 0 {
+		result = append(result, ops[start:end])
+	}
+	return result
+}
+
+// writeHunk writes an @@ header and the lines of one hunk
+func writeHunk(sb *strings.Builder, h []op, a, b []string) {
+	startA, startB := h[0].a, h[0].b
+	var countA, countB int
+	for _, o := range h {
+		if o.kind != opInsert {
+			countA++
+		}
+		if o.kind != opDelete {
+			countB++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(startA, countA), hunkRange(startB, countB))
+	for _, o := range h {
+		var line string
+		if o.kind == opInsert {
+			line = b[o.b]
+		} else {
+			line = a[o.a]
+		}
+		sb.WriteByte(byte(o.kind))
+		sb.WriteString(line)
+		if !strings.HasSuffix(line, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the start,count of a hunk, 1-based; an empty range
+// names the line before it
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,51 @@
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestUnified diffs every testdata/NAME.old against NAME.new and compares
+// the result with NAME.diff, which was made with diff -u
+func TestUnified(t *testing.T) {
+	olds, err := filepath.Glob(filepath.Join("testdata", "*.old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(olds) == 0 {
+		t.Fatal("no fixtures in testdata")
+	}
+	for _, old := range olds {
+		base := strings.TrimSuffix(old, ".old")
+		name := filepath.Base(base)
+		t.Run(name, func(t *testing.T) {
+			a := readFile(t, old)
+			b := readFile(t, base+".new")
+			want := readFile(t, base+".diff")
+			got := Unified("a/"+name, "b/"+name, a, b, DefaultContext)
+			if got != want {
+				t.Errorf("Unified(%s.old, %s.new):\n%s\n--- want\n%s", name, name, got, want)
+			}
+		})
+	}
+}
+
+func TestUnifiedContext(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n"
+	b := "1\n2\nthree\n4\n5\n"
+	want := "--- a\n+++ b\n@@ -3 +3 @@\n-3\n+three\n"
+	if got := Unified("a", "b", a, b, 0); got != want {
+		t.Errorf("Unified with no context:\n%s\n--- want\n%s", got, want)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
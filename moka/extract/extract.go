@@ -0,0 +1,343 @@
+// Package extract finds the main content of a web page, readability style,
+// so navigation, banners, footers and sidebars are left out of the Markdown.
+package extract
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/JohannesKaufmann/dom"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Article is the main content of a page and the metadata found around it
+type Article struct {
+	Title     string
+	Byline    string
+	Published string // As written in the page, usually an ISO 8601 timestamp
+	Content   string // HTML of the content root, without title or byline
+}
+
+var (
+	// unlikelyRe matches class/id values of page chrome
+	unlikelyRe = regexp.MustCompile(`(?i)banner|breadcrumb|combx|comment|community|consent|cookie|disqus|extra|footer|gdpr|header|legends|menu|modal|nav|newsletter|pager|pagination|popup|promo|related|remark|replies|rss|share|shoutbox|sidebar|skyscraper|social|sponsor|subscribe|tags|toolbar|widget|advert|\bads?\b`)
+	// likelyRe rescues elements that match unlikelyRe but look like content
+	likelyRe = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow|story|entry|post`)
+	// positiveRe and negativeRe adjust a candidate's score by class/id
+	positiveRe = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|pagination|post|text|blog|story`)
+	negativeRe = regexp.MustCompile(`(?i)hidden|^hid$|\shid$|\shid\s|^hid\s|banner|combx|comment|com-|contact|foot|footer|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
+	// bylineRe matches class/id/rel values of author elements
+	bylineRe = regexp.MustCompile(`(?i)byline|author|dateline|writtenby|p-author`)
+)
+
+// removedTags are dropped before scoring; they never hold article text
+var removedTags = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Nav:      true,
+	atom.Footer:   true,
+	atom.Aside:    true,
+	atom.Form:     true,
+	atom.Iframe:   true,
+	atom.Button:   true,
+	atom.Select:   true,
+	atom.Svg:      true,
+	atom.Template: true,
+	atom.Dialog:   true,
+}
+
+// scoredTags are the elements whose text contributes to their ancestors' scores
+var scoredTags = map[atom.Atom]bool{
+	atom.P:          true,
+	atom.Pre:        true,
+	atom.Td:         true,
+	atom.Blockquote: true,
+	atom.Li:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+}
+
+// Extract parses an HTML document and returns its main content. When no
+// content root scores well enough the whole <body> is returned.
+func Extract(document string) (*Article, error) {
+	doc, err := html.Parse(strings.NewReader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	byline, bylineNode := findByline(doc)
+	article := &Article{
+		Title:     findTitle(doc),
+		Byline:    byline,
+		Published: findPublished(doc),
+	}
+	if bylineNode != nil && bylineNode.Parent != nil {
+		// The byline is rendered by HTML, so drop it from the content
+		bylineNode.Parent.RemoveChild(bylineNode)
+	}
+
+	body := dom.FindFirstNode(doc, func(c *html.Node) bool { return c.DataAtom == atom.Body })
+	if body == nil {
+		body = doc
+	}
+	prune(body)
+
+	root := topCandidate(body)
+	if root == nil {
+		root = body
+	}
+	article.Content = renderChildren(root)
+	return article, nil
+}
+
+// HTML returns the content preceded by the title and byline, ready for
+// conversion. When the content already opens with the title heading, the
+// byline goes right after it instead.
+func (a *Article) HTML() string {
+	var meta []string
+	if a.Byline != "" {
+		meta = append(meta, "By "+a.Byline)
+	}
+	if a.Published != "" {
+		meta = append(meta, a.Published)
+	}
+	byline := ""
+	if len(meta) > 0 {
+		byline = "<p><em>" + html.EscapeString(strings.Join(meta, " · ")) + "</em></p>"
+	}
+
+	content := a.Content
+	titleEnd := html.EscapeString(a.Title) + "</h1>"
+	if i := strings.Index(content, titleEnd); a.Title != "" && i >= 0 {
+		i += len(titleEnd)
+		content = content[:i] + byline + content[i:]
+	} else {
+		if a.Title != "" {
+			byline = "<h1>" + html.EscapeString(a.Title) + "</h1>" + byline
+		}
+		content = byline + content
+	}
+	return "<article>" + content + "</article>"
+}
+
+// prune removes non-content elements and unlikely candidates in place
+func prune(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type == html.CommentNode || (c.Type == html.ElementNode && isUnlikely(c)) {
+			n.RemoveChild(c)
+		} else {
+			prune(c)
+		}
+		c = next
+	}
+}
+
+func isUnlikely(n *html.Node) bool {
+	// MathJax 2 keeps the TeX source of formulas in <script type="math/tex">
+	if n.DataAtom == atom.Script && strings.HasPrefix(strings.ToLower(dom.GetAttributeOr(n, "type", "")), "math/tex") {
+		return false
+	}
+	if removedTags[n.DataAtom] || dom.GetAttributeOr(n, "hidden", "") != "" || dom.GetAttributeOr(n, "aria-hidden", "") == "true" {
+		return true
+	}
+	if role := dom.GetAttributeOr(n, "role", ""); role == "navigation" || role == "banner" || role == "complementary" || role == "dialog" {
+		return true
+	}
+	if n.DataAtom == atom.Body || n.DataAtom == atom.Article || n.DataAtom == atom.Main || n.DataAtom == atom.A {
+		return false
+	}
+	match := dom.GetAttributeOr(n, "class", "") + " " + dom.GetAttributeOr(n, "id", "")
+	return unlikelyRe.MatchString(match) && !likelyRe.MatchString(match)
+}
+
+// topCandidate scores the ancestors of text blocks and returns the best one
+func topCandidate(body *html.Node) *html.Node {
+	scores := map[*html.Node]float64{}
+	initScore := func(n *html.Node) {
+		if _, ok := scores[n]; ok {
+			return
+		}
+		scores[n] = tagWeight(n) + classWeight(n)
+	}
+
+	for _, n := range dom.AllNodes(body) {
+		if n.Type != html.ElementNode || !scoredTags[n.DataAtom] || n.Parent == nil {
+			continue
+		}
+		text := innerText(n)
+		if len(text) < 25 {
+			continue
+		}
+		score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(len(text))/100, 3)
+
+		parent := n.Parent
+		initScore(parent)
+		scores[parent] += score
+		if grand := parent.Parent; grand != nil && grand.Type == html.ElementNode {
+			initScore(grand)
+			scores[grand] += score / 2
+		}
+	}
+
+	// Visit candidates in document order so the first of equal scores wins
+	var best *html.Node
+	bestScore := 0.0
+	for _, n := range dom.AllNodes(body) {
+		score, ok := scores[n]
+		if !ok {
+			continue
+		}
+		score *= 1 - linkDensity(n)
+		if score > bestScore {
+			best, bestScore = n, score
+		}
+	}
+	if best == nil || bestScore < 20 {
+		return nil
+	}
+
+	// Climb while the parent holds most of the text, so split articles
+	// (e.g. body and "continued" blocks) stay together
+	for best.Parent != nil && best.Parent != body && best.Parent.Type == html.ElementNode {
+		if len(innerText(best.Parent)) > len(innerText(best))*3/2 {
+			break
+		}
+		best = best.Parent
+	}
+	return best
+}
+
+func tagWeight(n *html.Node) float64 {
+	switch n.DataAtom {
+	case atom.Article, atom.Main:
+		return 10
+	case atom.Div:
+		return 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		return 3
+	case atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li, atom.Form:
+		return -3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		return -5
+	}
+	return 0
+}
+
+func classWeight(n *html.Node) float64 {
+	weight := 0.0
+	for _, v := range []string{dom.GetAttributeOr(n, "class", ""), dom.GetAttributeOr(n, "id", "")} {
+		if v == "" {
+			continue
+		}
+		if negativeRe.MatchString(v) {
+			weight -= 25
+		}
+		if positiveRe.MatchString(v) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// linkDensity is the share of a node's text that sits inside links
+func linkDensity(n *html.Node) float64 {
+	total := len(innerText(n))
+	if total == 0 {
+		return 0
+	}
+	linked := 0
+	for _, a := range dom.FindAllNodes(n, func(c *html.Node) bool { return c.DataAtom == atom.A }) {
+		linked += len(innerText(a))
+	}
+	return math.Min(float64(linked)/float64(total), 1)
+}
+
+func findTitle(doc *html.Node) string {
+	if t := metaContent(doc, "og:title"); t != "" {
+		return t
+	}
+	if h1 := dom.FindFirstNode(doc, func(c *html.Node) bool { return c.DataAtom == atom.H1 }); h1 != nil {
+		if t := innerText(h1); t != "" {
+			return t
+		}
+	}
+	if t := dom.FindFirstNode(doc, func(c *html.Node) bool { return c.DataAtom == atom.Title }); t != nil {
+		return innerText(t)
+	}
+	return ""
+}
+
+// findByline returns the author and, when it came from an element in the
+// page body rather than a <meta> tag, that element
+func findByline(doc *html.Node) (string, *html.Node) {
+	if a := metaContent(doc, "author"); a != "" {
+		return a, nil
+	}
+	for _, n := range dom.AllNodes(doc) {
+		if n.Type != html.ElementNode || n.DataAtom == atom.Meta {
+			continue
+		}
+		if dom.GetAttributeOr(n, "rel", "") == "author" || dom.GetAttributeOr(n, "itemprop", "") == "author" ||
+			bylineRe.MatchString(dom.GetAttributeOr(n, "class", "")+" "+dom.GetAttributeOr(n, "id", "")) {
+			if text := innerText(n); len(text) > 0 && len(text) < 100 {
+				return strings.TrimPrefix(strings.TrimPrefix(text, "By "), "by "), n
+			}
+		}
+	}
+	return "", nil
+}
+
+func findPublished(doc *html.Node) string {
+	for _, key := range []string{"article:published_time", "datePublished", "date", "dc.date", "pubdate"} {
+		if d := metaContent(doc, key); d != "" {
+			return d
+		}
+	}
+	if t := dom.FindFirstNode(doc, func(c *html.Node) bool { return c.DataAtom == atom.Time }); t != nil {
+		if d := dom.GetAttributeOr(t, "datetime", ""); d != "" {
+			return d
+		}
+	}
+	return ""
+}
+
+// metaContent returns the content of <meta name|property|itemprop=key>
+func metaContent(doc *html.Node, key string) string {
+	for _, n := range dom.FindAllNodes(doc, func(c *html.Node) bool { return c.DataAtom == atom.Meta }) {
+		for _, a := range []string{"property", "name", "itemprop"} {
+			if !strings.EqualFold(dom.GetAttributeOr(n, a, ""), key) {
+				continue
+			}
+			if content := strings.TrimSpace(dom.GetAttributeOr(n, "content", "")); content != "" {
+				return content
+			}
+			break
+		}
+	}
+	return ""
+}
+
+// innerText returns the whitespace-collapsed text of a node
+func innerText(n *html.Node) string {
+	var sb strings.Builder
+	for _, c := range dom.AllNodes(n) {
+		if c.Type == html.TextNode {
+			sb.WriteString(c.Data)
+			sb.WriteByte(' ')
+		}
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+func renderChildren(n *html.Node) string {
+	var buf bytes.Buffer
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		html.Render(&buf, c)
+	}
+	return buf.String()
+}
@@ -0,0 +1,78 @@
+package extract
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	htmltomarkdown "github.com/JohannesKaufmann/html-to-markdown/v2"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files from the current output")
+
+// TestGolden extracts every testdata/NAME.html and compares the article, as
+// Markdown, with testdata/NAME.golden.md
+func TestGolden(t *testing.T) {
+	pages, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) == 0 {
+		t.Fatal("no fixtures in testdata")
+	}
+	for _, page := range pages {
+		name := strings.TrimSuffix(filepath.Base(page), ".html")
+		t.Run(name, func(t *testing.T) {
+			document, err := os.ReadFile(page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := extractMarkdown(t, string(document))
+
+			golden := filepath.Join("testdata", name+".golden.md")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%s (run go test -update to create it)", err)
+			}
+			if got != string(want) {
+				t.Errorf("%s differs from %s:\n--- got\n%s\n--- want\n%s", page, golden, got, want)
+			}
+		})
+	}
+}
+
+// TestExtractDeterministic guards against map iteration order deciding
+// between equally scored candidates
+func TestExtractDeterministic(t *testing.T) {
+	document, err := os.ReadFile(filepath.Join("testdata", "tie.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := extractMarkdown(t, string(document))
+	for range 20 {
+		if got := extractMarkdown(t, string(document)); got != first {
+			t.Fatalf("Extract output changed between runs:\n%s\n--- then\n%s", first, got)
+		}
+	}
+}
+
+func extractMarkdown(t *testing.T, document string) string {
+	t.Helper()
+	article, err := Extract(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	markdown, err := htmltomarkdown.ConvertString(article.HTML())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return markdown + "\n"
+}
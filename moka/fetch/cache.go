@@ -0,0 +1,243 @@
+package fetch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Limits applied by AutoClean
+const (
+	DefaultMaxAge  = 30 * 24 * time.Hour // Entries unused for longer are removed
+	DefaultMaxSize = 500 << 20           // 500 MiB; least recently used entries go first
+	cleanInterval  = 24 * time.Hour
+	cleanStamp     = ".last-clean"
+)
+
+// ErrNotCached is returned in offline mode for URLs missing from the cache
+var ErrNotCached = errors.New("not in the cache")
+
+// Cache stores responses on disk, keyed by URL, so pages fetched again are
+// revalidated with If-None-Match/If-Modified-Since instead of downloaded.
+// Error responses below 500 are kept too, so offline runs see the same
+// missing pages (and robots.txt files) as the run that filled the cache.
+// Entries are evicted by Clean, least recently used first.
+type Cache struct {
+	Dir     string
+	Offline bool // Serve only from the cache, never touching the network
+}
+
+// cacheEntry is the metadata stored next to a cached body
+type cacheEntry struct {
+	URL        string      `json:"url"`
+	FinalURL   string      `json:"final_url"`
+	StatusCode int         `json:"status_code"`
+	Status     string      `json:"status"`
+	Header     http.Header `json:"header"`
+	Fetched    time.Time   `json:"fetched"`
+}
+
+// DefaultCacheDir is where responses are cached when no directory is given,
+// e.g. ~/.cache/moka/http
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "moka", "http")
+}
+
+// paths returns the files holding the entry and the body for url
+func (c *Cache) paths(url string) (string, string) {
+	sum := sha256.Sum256([]byte(url))
+	key := hex.EncodeToString(sum[:])
+	dir := filepath.Join(c.Dir, key[:2])
+	return filepath.Join(dir, key+".json"), filepath.Join(dir, key+".body")
+}
+
+// load returns the cached response for url, or nil when there is none
+func (c *Cache) load(url string) (*cacheEntry, []byte) {
+	entryPath, bodyPath := c.paths(url)
+	data, err := os.ReadFile(entryPath)
+	if err != nil {
+		return nil, nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != url {
+		return nil, nil
+	}
+	body, err := os.ReadFile(bodyPath)
+	if err != nil {
+		return nil, nil
+	}
+	// The entry's modification time records its last use for Clean
+	now := time.Now()
+	os.Chtimes(entryPath, now, now)
+	return &entry, body
+}
+
+// CleanStats reports what Clean removed and what is left
+type CleanStats struct {
+	Removed int   // Entries removed
+	Freed   int64 // Bytes freed
+	Kept    int   // Entries left
+	Size    int64 // Bytes left
+}
+
+// Clean removes entries unused for longer than maxAge, then the least
+// recently used ones until the cache is at most maxSize bytes. A zero limit
+// is no limit; a negative one removes every entry. Leftovers of interrupted
+// writes are removed too.
+func (c *Cache) Clean(maxAge time.Duration, maxSize int64) (CleanStats, error) {
+	type file struct {
+		entry, body string
+		size        int64
+		used        time.Time
+	}
+	var files []file
+	var stats CleanStats
+	err := filepath.WalkDir(c.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == c.Dir {
+				return fs.SkipAll
+			}
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() || name == cleanStamp {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.HasSuffix(name, ".json"):
+			body := strings.TrimSuffix(path, ".json") + ".body"
+			size := info.Size()
+			if bodyInfo, err := os.Stat(body); err == nil {
+				size += bodyInfo.Size()
+			}
+			files = append(files, file{entry: path, body: body, size: size, used: info.ModTime()})
+		case strings.HasSuffix(name, ".body"):
+			// Listed with its entry; a body without one is removed below
+			if _, err := os.Stat(strings.TrimSuffix(path, ".body") + ".json"); errors.Is(err, fs.ErrNotExist) {
+				stats.Freed += info.Size()
+				return os.Remove(path)
+			}
+		case strings.HasPrefix(name, ".tmp-") && time.Since(info.ModTime()) > time.Hour:
+			stats.Freed += info.Size()
+			return os.Remove(path)
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	// Newest first: once one entry does not fit, every older one goes too
+	slices.SortFunc(files, func(a, b file) int { return b.used.Compare(a.used) })
+	full := maxSize < 0
+	for _, f := range files {
+		expired := maxAge < 0 || (maxAge > 0 && time.Since(f.used) > maxAge)
+		if !expired && maxSize > 0 && stats.Size+f.size > maxSize {
+			full = true
+		}
+		if !expired && !full {
+			stats.Kept++
+			stats.Size += f.size
+			continue
+		}
+		// The entry goes first so a reader never finds it without its body
+		if err := os.Remove(f.entry); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return stats, err
+		}
+		if err := os.Remove(f.body); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return stats, err
+		}
+		os.Remove(filepath.Dir(f.entry)) // Only succeeds once the directory is empty
+		stats.Removed++
+		stats.Freed += f.size
+	}
+	return stats, nil
+}
+
+// AutoClean runs Clean with the default limits when it has not run for a
+// day, so the cache does not grow without bound between manual cleanups
+func (c *Cache) AutoClean() (CleanStats, error) {
+	stamp := filepath.Join(c.Dir, cleanStamp)
+	if info, err := os.Stat(stamp); err == nil && time.Since(info.ModTime()) < cleanInterval {
+		return CleanStats{}, nil
+	}
+	stats, err := c.Clean(DefaultMaxAge, DefaultMaxSize)
+	if err != nil {
+		return stats, err
+	}
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return stats, err
+	}
+	return stats, os.WriteFile(stamp, nil, 0644)
+}
+
+// store saves a response, replacing any previous one. The body is written
+// first so a reader never finds an entry without its body.
+func (c *Cache) store(entry *cacheEntry, body []byte) error {
+	entryPath, bodyPath := c.paths(entry.URL)
+	if err := os.MkdirAll(filepath.Dir(entryPath), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeAtomic(bodyPath, body); err != nil {
+		return err
+	}
+	return writeAtomic(entryPath, data)
+}
+
+// cacheable reports whether a response may be stored: final answers the
+// server did not forbid us to keep
+func cacheable(resp *http.Response) bool {
+	if resp.StatusCode >= 500 {
+		return false
+	}
+	return !strings.Contains(strings.ToLower(resp.Header.Get("Cache-Control")), "no-store")
+}
+
+// result builds the Result of a cached response, or its *StatusError
+func (e *cacheEntry) result(body []byte, fetched time.Time) (*Result, error) {
+	if e.StatusCode < 200 || e.StatusCode > 299 {
+		return nil, &StatusError{URL: e.URL, StatusCode: e.StatusCode, Status: e.Status}
+	}
+	return newResult(e.URL, e.FinalURL, e.StatusCode, e.Header, body, fetched), nil
+}
+
+func writeAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
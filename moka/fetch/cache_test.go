@@ -0,0 +1,103 @@
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheRevalidate(t *testing.T) {
+	var requests, notModified int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, "<p>cached</p>")
+	}))
+	defer srv.Close()
+
+	f := New()
+	f.Cache = &Cache{Dir: t.TempDir()}
+	for range 2 {
+		res, err := f.Fetch(context.Background(), srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res.Body) != "<p>cached</p>" {
+			t.Errorf("Body = %q", res.Body)
+		}
+	}
+	if requests != 2 || notModified != 1 {
+		t.Errorf("%d requests, %d not modified; want 2 and 1", requests, notModified)
+	}
+
+	f.Cache.Offline = true
+	srv.Close()
+	if _, err := f.Fetch(context.Background(), srv.URL); err != nil {
+		t.Errorf("offline Fetch of a cached page: %v", err)
+	}
+	if _, err := f.Fetch(context.Background(), srv.URL+"/other"); err == nil || !strings.Contains(err.Error(), ErrNotCached.Error()) {
+		t.Errorf("offline Fetch of an uncached page: error = %v, want ErrNotCached", err)
+	}
+}
+
+func TestCacheClean(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("x", 1000))
+	}))
+	defer srv.Close()
+
+	// Pages 0-3, last used 0-3 days ago
+	c := &Cache{Dir: t.TempDir()}
+	f := New()
+	f.Cache = c
+	urls := make([]string, 4)
+	for i := range urls {
+		urls[i] = fmt.Sprintf("%s/%d", srv.URL, i)
+		if _, err := f.Fetch(context.Background(), urls[i]); err != nil {
+			t.Fatal(err)
+		}
+		used := time.Now().Add(-time.Duration(i) * 24 * time.Hour)
+		entry, _ := c.paths(urls[i])
+		if err := os.Chtimes(entry, used, used); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cached := func() []bool {
+		var found []bool
+		for _, u := range urls {
+			entry, _ := c.load(u)
+			found = append(found, entry != nil)
+		}
+		return found
+	}
+
+	// Page 3 is too old; pages 1 and 2 do not fit once page 0 is counted.
+	// Loading in cached() marks every page left as just used.
+	stats, err := c.Clean(70*time.Hour, 1500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Removed != 3 || stats.Kept != 1 {
+		t.Errorf("Clean removed %d and kept %d entries, want 3 and 1", stats.Removed, stats.Kept)
+	}
+	if got := fmt.Sprint(cached()); got != "[true false false false]" {
+		t.Errorf("cached after Clean = %s, want only page 0", got)
+	}
+
+	if _, err := c.Clean(-1, -1); err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(cached()); got != "[false false false false]" {
+		t.Errorf("cached after removing everything = %s", got)
+	}
+}
@@ -0,0 +1,247 @@
+// Package fetch downloads web pages for conversion and decodes them to UTF-8.
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// Defaults used by New
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultMaxRedirects = 10
+	DefaultMaxBodySize  = 20 << 20 // 20 MiB
+	DefaultUserAgent    = "moka/1.0 (+https://github.com/limpdev/limpbin)"
+)
+
+// Fetcher is an HTTP client configured for fetching pages to convert
+type Fetcher struct {
+	Client      *http.Client
+	UserAgent   string
+	MaxBodySize int64  // Responses larger than this are rejected
+	Cache       *Cache // Stores and revalidates responses when set
+}
+
+// Result is a fetched page with its body transcoded to UTF-8
+type Result struct {
+	URL         string // The URL that was requested
+	FinalURL    string // The URL after following redirects
+	StatusCode  int
+	Header      http.Header
+	ContentType string // Media type without parameters, e.g. text/html
+	Charset     string // Charset the body was decoded from, e.g. windows-1252 (Fetch only)
+	Body        []byte // UTF-8 encoded body for Fetch, raw bytes for Download
+	Fetched     time.Time
+}
+
+// StatusError is returned for non-2xx responses
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("fetching %s: unexpected status %s", e.URL, e.Status)
+}
+
+// ErrTooLarge is returned when a body exceeds MaxBodySize
+var ErrTooLarge = errors.New("response body too large")
+
+// New returns a Fetcher with the default timeout, redirect limit and user agent
+func New() *Fetcher {
+	return &Fetcher{
+		Client: &http.Client{
+			Timeout: DefaultTimeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				// via holds the original request and every redirect so far
+				if len(via) > DefaultMaxRedirects {
+					return fmt.Errorf("stopped after %d redirects", DefaultMaxRedirects)
+				}
+				return nil
+			},
+		},
+		UserAgent:   DefaultUserAgent,
+		MaxBodySize: DefaultMaxBodySize,
+	}
+}
+
+// Fetch downloads url and returns its body decoded to UTF-8. Non-2xx
+// responses are reported as a *StatusError.
+func (f *Fetcher) Fetch(ctx context.Context, url string) (*Result, error) {
+	res, err := f.get(ctx, url, "text/html,application/xhtml+xml;q=0.9,*/*;q=0.8")
+	if err != nil {
+		return nil, err
+	}
+	body, name, err := DecodeUTF8(res.Body, res.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", url, err)
+	}
+	res.Body = body
+	res.Charset = name
+	return res, nil
+}
+
+// Download fetches url and returns its body as-is, for binary assets such
+// as images. Non-2xx responses are reported as a *StatusError.
+func (f *Fetcher) Download(ctx context.Context, url string) (*Result, error) {
+	return f.get(ctx, url, "*/*")
+}
+
+// Offline reports whether the fetcher serves only from its cache
+func (f *Fetcher) Offline() bool {
+	return f.Cache != nil && f.Cache.Offline
+}
+
+// get performs a GET request and reads the body up to MaxBodySize. With a
+// cache, a stored response is revalidated and reused when unchanged.
+func (f *Fetcher) get(ctx context.Context, url, accept string) (*Result, error) {
+	var cached *cacheEntry
+	var cachedBody []byte
+	if f.Cache != nil {
+		cached, cachedBody = f.Cache.load(url)
+		if f.Cache.Offline {
+			if cached == nil {
+				return nil, fmt.Errorf("fetching %s: %w", url, ErrNotCached)
+			}
+			return cached.result(cachedBody, cached.Fetched)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.UserAgent)
+	req.Header.Set("Accept", accept)
+	if cached != nil {
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	fetched := time.Now()
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return cached.result(cachedBody, fetched)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		f.store(url, resp, nil)
+		return nil, &StatusError{URL: url, StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, f.MaxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", url, err)
+	}
+	if int64(len(raw)) > f.MaxBodySize {
+		return nil, fmt.Errorf("fetching %s: %w", url, ErrTooLarge)
+	}
+
+	f.store(url, resp, raw)
+	return newResult(url, resp.Request.URL.String(), resp.StatusCode, resp.Header, raw, fetched), nil
+}
+
+// store caches a response when there is a cache and the response allows it.
+// A failure to cache is not a failure to fetch, so errors are only logged.
+// Entries are keyed by the URL requested, before redirects.
+func (f *Fetcher) store(url string, resp *http.Response, body []byte) {
+	if f.Cache == nil || !cacheable(resp) {
+		return
+	}
+	entry := &cacheEntry{
+		URL:        url,
+		FinalURL:   resp.Request.URL.String(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Fetched:    time.Now(),
+	}
+	if err := f.Cache.store(entry, body); err != nil {
+		log.Printf("Could not cache %s: %s", url, err)
+	}
+}
+
+func newResult(url, finalURL string, status int, header http.Header, body []byte, fetched time.Time) *Result {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	return &Result{
+		URL:         url,
+		FinalURL:    finalURL,
+		StatusCode:  status,
+		Header:      header,
+		ContentType: mediaType,
+		Body:        body,
+		Fetched:     fetched,
+	}
+}
+
+// Open reads a saved HTML page from a local file, or from stdin when path is
+// "-", and returns it like a fetched page. FinalURL is the file's file://
+// URL, or empty for stdin.
+func Open(path string) (*Result, error) {
+	var raw []byte
+	var err error
+	result := &Result{URL: path, StatusCode: http.StatusOK, Header: http.Header{}, Fetched: time.Now()}
+	if path == "-" {
+		raw, err = io.ReadAll(os.Stdin)
+	} else {
+		raw, err = os.ReadFile(path)
+		if abs, absErr := filepath.Abs(path); absErr == nil {
+			slashed := filepath.ToSlash(abs)
+			if !strings.HasPrefix(slashed, "/") {
+				slashed = "/" + slashed // C:/page.html -> /C:/page.html
+			}
+			result.FinalURL = (&url.URL{Scheme: "file", Path: slashed}).String()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a header, the charset comes from a BOM or <meta> tag
+	body, name, err := DecodeUTF8(raw, "text/html")
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	result.ContentType = "text/html"
+	result.Charset = name
+	result.Body = body
+	return result, nil
+}
+
+// DecodeUTF8 transcodes an HTML document to UTF-8. The charset is taken from
+// a byte order mark, the Content-Type header or a <meta> tag, in that order,
+// falling back to windows-1252 as browsers do.
+func DecodeUTF8(raw []byte, contentType string) ([]byte, string, error) {
+	enc, name, _ := charset.DetermineEncoding(raw, contentType)
+	if strings.EqualFold(name, "utf-8") {
+		return bytes.TrimPrefix(raw, []byte("\xEF\xBB\xBF")), name, nil
+	}
+	body, err := enc.NewDecoder().Bytes(raw)
+	if err != nil {
+		return nil, name, err
+	}
+	// A UTF-16 BOM decodes to U+FEFF
+	return bytes.TrimPrefix(body, []byte("\uFEFF")), name, nil
+}
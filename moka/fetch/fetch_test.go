@@ -0,0 +1,165 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFetchStatusError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := New().Fetch(context.Background(), srv.URL)
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("Fetch error = %v, want a *StatusError", err)
+	}
+	if statusErr.StatusCode != http.StatusNotFound || statusErr.URL != srv.URL {
+		t.Errorf("StatusError = %+v, want status 404 for %s", statusErr, srv.URL)
+	}
+}
+
+func TestFetchRedirectLimit(t *testing.T) {
+	// /N redirects N more times before answering
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/"))
+		if n > 0 {
+			http.Redirect(w, r, fmt.Sprintf("/%d", n-1), http.StatusFound)
+			return
+		}
+		fmt.Fprint(w, "<p>done</p>")
+	}))
+	defer srv.Close()
+
+	url := fmt.Sprintf("%s/%d", srv.URL, DefaultMaxRedirects)
+	res, err := New().Fetch(context.Background(), url)
+	if err != nil {
+		t.Fatalf("Fetch after %d redirects: %v", DefaultMaxRedirects, err)
+	}
+	if res.FinalURL != srv.URL+"/0" {
+		t.Errorf("FinalURL = %q, want %q", res.FinalURL, srv.URL+"/0")
+	}
+
+	url = fmt.Sprintf("%s/%d", srv.URL, DefaultMaxRedirects+1)
+	if _, err := New().Fetch(context.Background(), url); err == nil || !strings.Contains(err.Error(), "redirects") {
+		t.Errorf("Fetch after %d redirects: error = %v, want the redirect limit", DefaultMaxRedirects+1, err)
+	}
+}
+
+func TestFetchMaxBodySize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/"))
+		fmt.Fprint(w, strings.Repeat("x", n))
+	}))
+	defer srv.Close()
+
+	f := New()
+	f.MaxBodySize = 100
+	if _, err := f.Fetch(context.Background(), srv.URL+"/100"); err != nil {
+		t.Errorf("Fetch of a body at the limit: %v", err)
+	}
+	if _, err := f.Fetch(context.Background(), srv.URL+"/101"); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Fetch of a body over the limit: error = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestFetchUserAgent(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.UserAgent()
+	}))
+	defer srv.Close()
+
+	f := New()
+	if _, err := f.Fetch(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if got != DefaultUserAgent {
+		t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+	}
+
+	f.UserAgent = "custom/2.0"
+	if _, err := f.Fetch(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if got != "custom/2.0" {
+		t.Errorf("User-Agent = %q, want %q", got, "custom/2.0")
+	}
+}
+
+func TestFetchCharset(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+		charset     string
+	}{
+		{
+			name:        "header",
+			contentType: "text/html; charset=windows-1252",
+			body:        "<p>caf\xe9</p>",
+			want:        "<p>café</p>",
+			charset:     "windows-1252",
+		},
+		{
+			name:        "meta",
+			contentType: "text/html",
+			body:        `<meta charset="koi8-r"><p>` + "\xd0\xd2\xc9\xd7\xc5\xd4" + "</p>",
+			want:        `<meta charset="koi8-r"><p>привет</p>`,
+			charset:     "koi8-r",
+		},
+		{
+			name:        "header over meta",
+			contentType: "text/html; charset=utf-8",
+			body:        `<meta charset="windows-1252"><p>café</p>`,
+			want:        `<meta charset="windows-1252"><p>café</p>`,
+			charset:     "utf-8",
+		},
+		{
+			name:        "UTF-8 BOM",
+			contentType: "text/html; charset=windows-1252",
+			body:        "\xef\xbb\xbf<p>café</p>",
+			want:        "<p>café</p>",
+			charset:     "utf-8",
+		},
+		{
+			name:        "UTF-16 BOM",
+			contentType: "text/html",
+			body:        "\xff\xfe<\x00p\x00>\x00\xe9\x00<\x00/\x00p\x00>\x00",
+			want:        "<p>é</p>",
+			charset:     "utf-16le",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			res, err := New().Fetch(context.Background(), srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(res.Body) != tt.want {
+				t.Errorf("Body = %q, want %q", res.Body, tt.want)
+			}
+			if res.Charset != tt.charset {
+				t.Errorf("Charset = %q, want %q", res.Charset, tt.charset)
+			}
+			if res.ContentType != "text/html" {
+				t.Errorf("ContentType = %q, want text/html", res.ContentType)
+			}
+		})
+	}
+}
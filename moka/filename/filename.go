@@ -0,0 +1,171 @@
+// Package filename derives Markdown file names for converted pages.
+package filename
+
+import (
+	"cmp"
+	"errors"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FromURL names a conversion after the URL's hostname and the time, e.g.
+// "example-com-20250102-150405.md"
+func FromURL(rawURL string, t time.Time) string {
+	hostname := ""
+	if u, err := url.Parse(rawURL); err == nil {
+		hostname = u.Host
+	}
+
+	// Clean the hostname to make it a valid filename
+	hostname = strings.ReplaceAll(hostname, ".", "-")
+	hostname = strings.ReplaceAll(hostname, ":", "-")
+	if hostname == "" {
+		hostname = "page"
+	}
+
+	return hostname + "-" + t.Format("20060102-150405") + ".md"
+}
+
+// DefaultTemplate names files after the page title alone
+const DefaultTemplate = "{slug}.md"
+
+// MaxSlugLength bounds the slug, leaving room for the rest of the template
+// and a collision suffix within common 255-byte name limits
+const MaxSlugLength = 80
+
+// Fields are the values a name template can refer to
+type Fields struct {
+	Title string    // Page title, slugified for {slug}
+	URL   string    // Page URL, for {host} and as the slug fallback
+	Time  time.Time // Conversion time, for {date} and {time}
+}
+
+// FromTemplate fills a name template. The placeholders are {slug} (the
+// title, or else the last URL path segment, as a slug), {host}, {date}
+// (2006-01-02) and {time} (150405), e.g. "{date}-{host}-{slug}.md".
+func FromTemplate(tmpl string, f Fields) string {
+	host, fallback := "page", "page"
+	if u, err := url.Parse(f.URL); err == nil && u.Host != "" {
+		host = Slug(strings.TrimPrefix(u.Hostname(), "www."), MaxSlugLength)
+		fallback = host
+		if segment := path.Base(strings.TrimSuffix(u.Path, "/")); segment != "." && segment != "/" {
+			fallback = cmp.Or(Slug(strings.TrimSuffix(segment, path.Ext(segment)), MaxSlugLength), host)
+		}
+	}
+	slug := cmp.Or(Slug(f.Title, MaxSlugLength), fallback)
+
+	name := strings.NewReplacer(
+		"{slug}", slug,
+		"{host}", host,
+		"{date}", f.Time.Format("2006-01-02"),
+		"{time}", f.Time.Format("150405"),
+	).Replace(tmpl)
+	// A template must not reach outside the output directory
+	name = strings.NewReplacer("/", "-", `\`, "-").Replace(name)
+	if strings.Trim(name, ".") == "" {
+		name = slug + ".md"
+	}
+	return name
+}
+
+// transliterations spell letters that do not decompose into an ASCII base
+// letter and combining marks
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th",
+	'ł': "l", 'ı': "i", 'ħ': "h", 'ŋ': "ng", 'ĸ': "k",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// Slug turns text into a lowercase, dash-separated file name part of at
+// most maxLen bytes. Accented Latin, Greek and Cyrillic letters are spelled
+// in ASCII; letters of other scripts (e.g. CJK) are kept as they are.
+func Slug(text string, maxLen int) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		for _, c := range spell(r) {
+			if c == '-' {
+				dash = sb.Len() > 0
+				continue
+			}
+			if dash {
+				sb.WriteByte('-')
+				dash = false
+			}
+			sb.WriteRune(c)
+		}
+	}
+
+	slug := sb.String()
+	if len(slug) > maxLen {
+		cut := 0
+		for i := range slug {
+			if i > maxLen {
+				break
+			}
+			cut = i
+		}
+		// Prefer ending on a word boundary when one is near
+		if i := strings.LastIndexByte(slug[:cut], '-'); i > maxLen/2 {
+			cut = i
+		}
+		slug = strings.TrimRight(slug[:cut], "-")
+	}
+	return slug
+}
+
+// spell writes r with the transliterations, or decomposes it dropping the
+// accents, e.g. "é" to "e", and turns anything but letters and digits into
+// a separator
+func spell(r rune) string {
+	if t, ok := transliterations[r]; ok {
+		return t
+	}
+	var sb strings.Builder
+	for _, c := range norm.NFKD.String(string(r)) {
+		c = unicode.ToLower(c)
+		switch {
+		case unicode.Is(unicode.Mn, c):
+		case transliterations[c] != "":
+			sb.WriteString(transliterations[c])
+		case unicode.IsLetter(c) || unicode.IsDigit(c):
+			sb.WriteRune(c)
+		default:
+			sb.WriteByte('-')
+		}
+	}
+	return sb.String()
+}
+
+// Unique returns path, or when a file already exists there, the same name
+// with the first free -2, -3... suffix before the extension
+func Unique(path string) string {
+	if _, err := os.Lstat(path); errors.Is(err, os.ErrNotExist) {
+		return path
+	}
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(path, ext)
+	for i := 2; ; i++ {
+		candidate := base + "-" + strconv.Itoa(i) + ext
+		if _, err := os.Lstat(candidate); errors.Is(err, os.ErrNotExist) {
+			return candidate
+		}
+	}
+}
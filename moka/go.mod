@@ -2,9 +2,11 @@ module limpdev/moka
 
 go 1.24.1
 
-require github.com/JohannesKaufmann/html-to-markdown/v2 v2.3.0
-
 require (
-	github.com/JohannesKaufmann/dom v0.2.0 // indirect
-	golang.org/x/net v0.37.0 // indirect
+	github.com/JohannesKaufmann/dom v0.2.0
+	github.com/JohannesKaufmann/html-to-markdown/v2 v2.3.0
+	github.com/andybalholm/cascadia v1.3.3
+	github.com/russross/blackfriday/v2 v2.1.0
+	golang.org/x/net v0.37.0
+	golang.org/x/text v0.23.0
 )
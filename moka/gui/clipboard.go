@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// The clipboard is read and written with the tools each desktop ships:
+// wl-clipboard or xclip on Linux, pbpaste and osascript on macOS and
+// PowerShell on Windows
+
+// errNoClipboard means no clipboard tool was found
+var errNoClipboard = errors.New("no clipboard tool found, install wl-clipboard or xclip")
+
+// clipboardText returns the plain text on the clipboard
+func clipboardText() (string, error) {
+	switch runtime.GOOS {
+	case "windows":
+		return powershell("Get-Clipboard -Raw", "")
+	case "darwin":
+		return output(nil, "pbpaste")
+	}
+	if !wayland() && !hasCommand("xclip") {
+		return "", errNoClipboard
+	}
+	return paste("")
+}
+
+// clipboardHTML returns the HTML on the clipboard, as put there by copying a
+// selection in a browser, and the URL of the page it came from when the
+// platform records it. It returns "" when the clipboard holds no HTML.
+func clipboardHTML() (document, sourceURL string, err error) {
+	switch runtime.GOOS {
+	case "windows":
+		// CF_HTML: a header of offsets and the source URL, then the HTML
+		// with the selection between fragment comments
+		raw, err := powershell("Get-Clipboard -Format Text -TextFormatType Html -Raw", "")
+		if err != nil || raw == "" {
+			return "", "", err
+		}
+		document, sourceURL = parseCFHTML(raw)
+		return document, sourceURL, nil
+	case "darwin":
+		// AppleScript prints the data as «data HTML3C6D657461...»
+		raw, err := output(nil, "osascript", "-e", "the clipboard as «class HTML»")
+		if err != nil {
+			return "", "", nil // No HTML on the clipboard
+		}
+		raw = strings.TrimSpace(raw)
+		raw = strings.TrimPrefix(raw, "«data HTML")
+		raw = strings.TrimSuffix(raw, "»")
+		data, err := hex.DecodeString(raw)
+		if err != nil {
+			return "", "", err
+		}
+		return string(data), "", nil
+	}
+
+	var list string
+	switch {
+	case wayland():
+		list, err = output(nil, "wl-paste", "--list-types")
+	case hasCommand("xclip"):
+		list, err = output(nil, "xclip", "-selection", "clipboard", "-o", "-t", "TARGETS")
+	default:
+		return "", "", errNoClipboard
+	}
+	if err != nil || !hasLine(list, "text/html") {
+		return "", "", nil
+	}
+	if document, err = paste("text/html"); err != nil {
+		return "", "", err
+	}
+	// Firefox records the page of the selection as text/x-moz-url-priv
+	if hasLine(list, "text/x-moz-url-priv") {
+		if u, err := paste("text/x-moz-url-priv"); err == nil {
+			sourceURL = strings.TrimSpace(u)
+		}
+	}
+	return document, sourceURL, nil
+}
+
+// paste reads one type from the clipboard with wl-paste or xclip, or the
+// text when mimeType is ""
+func paste(mimeType string) (string, error) {
+	args := []string{"xclip", "-selection", "clipboard", "-o"}
+	if wayland() {
+		args = []string{"wl-paste", "--no-newline"}
+	}
+	if mimeType != "" {
+		args = append(args, "-t", mimeType)
+	}
+	return output(nil, args[0], args[1:]...)
+}
+
+// setClipboard replaces the clipboard with text
+func setClipboard(text string) error {
+	switch runtime.GOOS {
+	case "windows":
+		_, err := powershell("Set-Clipboard -Value ([Console]::In.ReadToEnd())", text)
+		return err
+	case "darwin":
+		_, err := output(strings.NewReader(text), "pbcopy")
+		return err
+	}
+	// wl-copy and xclip fork a child that keeps serving the clipboard and
+	// exit once they have read the text. Run waits for that exit only: their
+	// output is not captured, as the child would hold the pipe open.
+	var cmd *exec.Cmd
+	switch {
+	case wayland():
+		cmd = exec.Command("wl-copy")
+	case hasCommand("xclip"):
+		cmd = exec.Command("xclip", "-selection", "clipboard", "-i")
+	default:
+		return errNoClipboard
+	}
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+// parseCFHTML returns the copied fragment and the SourceURL header of
+// Windows' HTML clipboard format
+func parseCFHTML(raw string) (fragment, sourceURL string) {
+	header, _, _ := strings.Cut(raw, "<")
+	for _, line := range strings.Split(header, "\n") {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(line), "SourceURL:"); ok {
+			sourceURL = v
+		}
+	}
+	_, fragment, ok := strings.Cut(raw, "<!--StartFragment-->")
+	if !ok {
+		return raw[len(header):], sourceURL
+	}
+	fragment, _, _ = strings.Cut(fragment, "<!--EndFragment-->")
+	return fragment, sourceURL
+}
+
+// powershell runs a PowerShell command with UTF-8 input and output
+func powershell(command, stdin string) (string, error) {
+	script := "[Console]::InputEncoding = [Console]::OutputEncoding = [Text.Encoding]::UTF8; " + command
+	return output(strings.NewReader(stdin), "powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+}
+
+// output runs a command and returns its standard output, with standard
+// error in the error when it fails
+func output(stdin *strings.Reader, name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	if runtime.GOOS == "darwin" {
+		// pbcopy and pbpaste assume MacRoman without a UTF-8 locale
+		cmd.Env = append(os.Environ(), "LANG=en_US.UTF-8")
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", errors.New(msg)
+		}
+		return "", err
+	}
+	return string(out), nil
+}
+
+func wayland() bool {
+	return os.Getenv("WAYLAND_DISPLAY") != "" && hasCommand("wl-paste")
+}
+
+func hasCommand(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
+
+func hasLine(text, line string) bool {
+	for _, l := range strings.Split(text, "\n") {
+		if strings.TrimSpace(l) == line {
+			return true
+		}
+	}
+	return false
+}
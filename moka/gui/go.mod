@@ -1,19 +1,24 @@
-module limpdev/moka
+module limpdev/moka/gui
 
 go 1.24.1
 
 require (
-	github.com/JohannesKaufmann/html-to-markdown/v2 v2.3.0
 	github.com/ncruces/zenity v0.10.14
+	limpdev/moka v0.0.0-00010101000000-000000000000
 )
 
 require (
 	github.com/JohannesKaufmann/dom v0.2.0 // indirect
+	github.com/JohannesKaufmann/html-to-markdown/v2 v2.3.0 // indirect
 	github.com/akavel/rsrc v0.10.2 // indirect
+	github.com/andybalholm/cascadia v1.3.3 // indirect
 	github.com/dchest/jsmin v0.0.0-20220218165748-59f39799265f // indirect
 	github.com/josephspurrier/goversioninfo v1.4.1 // indirect
 	github.com/randall77/makefat v0.0.0-20210315173500-7ddd0e42c844 // indirect
 	golang.org/x/image v0.20.0 // indirect
 	golang.org/x/net v0.37.0 // indirect
 	golang.org/x/sys v0.31.0 // indirect
+	golang.org/x/text v0.23.0 // indirect
 )
+
+replace limpdev/moka => ../
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"slices"
+	"time"
+)
+
+// maxHistory bounds the history; the oldest conversions are dropped first
+const maxHistory = 200
+
+// historyEntry records one conversion
+type historyEntry struct {
+	URL       string    `json:"url"`
+	Path      string    `json:"path"`
+	Title     string    `json:"title,omitempty"`
+	Converted time.Time `json:"converted"`
+}
+
+// history is the list of past conversions, newest first
+type history struct {
+	path    string
+	Entries []historyEntry `json:"entries"`
+}
+
+// loadHistory reads the history file; a missing file is an empty history
+func loadHistory(path string) (*history, error) {
+	h := &history{path: path}
+	if err := readJSON(path, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Add records a conversion, replacing an earlier entry for the same file
+func (h *history) Add(entry historyEntry) error {
+	h.Entries = slices.DeleteFunc(h.Entries, func(e historyEntry) bool { return e.Path == entry.Path })
+	h.Entries = slices.Insert(h.Entries, 0, entry)
+	if len(h.Entries) > maxHistory {
+		h.Entries = h.Entries[:maxHistory]
+	}
+	return h.save()
+}
+
+// Remove forgets the entry for path
+func (h *history) Remove(path string) error {
+	h.Entries = slices.DeleteFunc(h.Entries, func(e historyEntry) bool { return e.Path == path })
+	return h.save()
+}
+
+func (h *history) save() error {
+	return writeJSON(h.path, h)
+}
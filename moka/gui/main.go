@@ -1,88 +1,206 @@
 package main
 
 import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
 	"log"
+	"net/url"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
-	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/base"
-	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/commonmark"
-	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/table"
 	"github.com/ncruces/zenity"
+	"limpdev/moka/convert"
+	"limpdev/moka/fetch"
+	"limpdev/moka/filename"
+	"limpdev/moka/links"
+	"limpdev/moka/sites"
+)
+
+var (
+	fullPage    = flag.Bool("full-page", false, "Convert the whole page instead of only the main content")
+	profileName = flag.String("profile", convert.DefaultProfile, "Markdown output profile `NAME` (default, github, obsidian, plain or one from --config)")
+	configPath  = flag.String("config", "", "Read profiles from `FILE` instead of "+convert.DefaultConfigPath())
+	sitesPath   = flag.String("sites", "", "Read site rules from `FILE` instead of "+sites.DefaultConfigPath())
+	outDir      = flag.String("out", "", "Save to `DIR` instead of the output folder chosen in the menu (default ~/Downloads)")
+	noClipboard = flag.Bool("no-clipboard", false, "Neither pre-fill a copied URL nor offer to convert copied HTML")
+	nameTmpl    = flag.String("name", "", "Name files with `TEMPLATE` instead of the one chosen in the menu, e.g. {date}-{host}-{slug}.md (default "+filename.DefaultTemplate+")")
 )
 
 func main() {
-	url := promptForURL()
-	if url == "" {
-		return // User canceled or error occurred
+	flag.Parse()
+
+	prefs, err := loadSettings(configFile("gui.json"))
+	if err != nil {
+		zenity.Error("Error loading settings: " + err.Error())
+		return
 	}
+	hist, err := loadHistory(configFile("history.json"))
+	if err != nil {
+		zenity.Error("Error loading history: " + err.Error())
+		return
+	}
+
+	// A copied URL pre-fills the dialog; a copied selection can be converted
+	// in place without asking for a URL at all
+	initial := ""
+	if !*noClipboard {
+		var done bool
+		if initial, done = captureClipboard(); done {
+			return
+		}
+	}
+
+	for {
+		url, menu := promptForURL(initial)
+		if menu {
+			if !showMenu(prefs, hist) {
+				return
+			}
+			continue
+		}
+		if url == "" {
+			return // User canceled or error occurred
+		}
 
-	// Generate a filename based on the URL
-	filename := generateFilename(url)
+		dir := *outDir
+		if dir == "" {
+			if dir, err = prefs.outputDir(); err != nil {
+				log.Fatal("Could not find home directory:", err)
+			}
+		}
+		tmpl := cmp.Or(*nameTmpl, prefs.filenameTemplate())
 
-	// Convert the URL and save to the downloads folder
-	convertURL(url, filename)
+		// Convert the URL and save to the output folder, named after the page title
+		path, title, ok := convertURL(url, func(title string) string {
+			return generateFilename(dir, tmpl, url, title)
+		})
+		if ok {
+			recordConversion(hist, url, path, title)
+		}
+		return
+	}
 }
 
-func promptForURL() string {
+// promptForURL asks for the URL to convert, starting with initial. menu is
+// true when the user pressed the Menu button instead.
+func promptForURL(initial string) (url string, menu bool) {
 	text, err := zenity.Entry("Enter URL",
+		zenity.EntryText(initial),
 		zenity.Title("Moka"),
 		zenity.Width(400),
 		zenity.Height(50),
 		zenity.OKLabel("Rip"),
 		zenity.CancelLabel("Cancel"),
+		zenity.ExtraButton("Menu"),
 		zenity.WindowIcon("icon.png"),
 		zenity.Icon("icon.png"),
 	)
+	if errors.Is(err, zenity.ErrExtraButton) {
+		return "", true
+	}
+	if errors.Is(err, zenity.ErrCanceled) {
+		return "", false
+	}
 	if err != nil {
 		log.Fatal("Failed to show entry dialog:", err)
 	}
-	return text
+	return text, false
 }
 
-//func isValidURL(text string) bool {
-//	// Basic URL validation
-//	return strings.HasPrefix(text, "http://") || strings.HasPrefix(text, "https://")
-//}
+func isValidURL(text string) bool {
+	u, err := url.Parse(text)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
 
-func generateFilename(url string) string {
-	// Get user's download directory
-	homeDir, err := os.UserHomeDir()
+// captureClipboard returns the URL on the clipboard, if any. Otherwise, when
+// the clipboard holds HTML copied from a page, it offers to convert it and
+// returns done once the Markdown replaced it on the clipboard.
+func captureClipboard() (url string, done bool) {
+	text, err := clipboardText()
 	if err != nil {
-		log.Fatal("Could not find home directory:", err)
+		log.Printf("Could not read the clipboard: %s", err)
+		return "", false
+	}
+	if text = strings.TrimSpace(text); isValidURL(text) {
+		return text, false
 	}
 
-	downloadsDir := filepath.Join(homeDir, "Downloads")
+	document, sourceURL, err := clipboardHTML()
+	if err != nil {
+		log.Printf("Could not read HTML from the clipboard: %s", err)
+		return "", false
+	}
+	if strings.TrimSpace(document) == "" {
+		return "", false
+	}
+	err = zenity.Question("The clipboard holds a copied selection. Convert it to Markdown on the clipboard?",
+		zenity.Title("Moka"),
+		zenity.OKLabel("Convert"),
+		zenity.CancelLabel("Enter URL"),
+		zenity.WindowIcon("icon.png"),
+	)
+	if err != nil {
+		return "", false
+	}
+	return "", convertClipboard(document, sourceURL)
+}
 
-	// Create a filename using the hostname from the URL and current timestamp
-	hostname := ""
-	if startIdx := strings.Index(url, "://"); startIdx != -1 {
-		trimmedURL := url[startIdx+3:]
-		if endIdx := strings.Index(trimmedURL, "/"); endIdx != -1 {
-			hostname = trimmedURL[:endIdx]
+// convertClipboard converts copied HTML and puts the Markdown back on the
+// clipboard, resolving relative links against sourceURL when it is known
+func convertClipboard(document, sourceURL string) bool {
+	profile, err := convert.Lookup(*profileName, *configPath)
+	if err != nil {
+		zenity.Error("Error loading profile: " + err.Error())
+		return false
+	}
+	if sourceURL != "" {
+		if resolved, err := links.Resolve(document, sourceURL); err != nil {
+			log.Printf("Could not resolve relative links: %s", err)
 		} else {
-			hostname = trimmedURL
+			document = resolved
 		}
 	}
+	markdown, err := profile.Converter().ConvertString(document)
+	if err != nil {
+		zenity.Error("Error converting HTML to Markdown: " + err.Error())
+		return false
+	}
+	if err := setClipboard(strings.TrimSpace(markdown) + "\n"); err != nil {
+		zenity.Error("Could not copy the Markdown to the clipboard: " + err.Error())
+		return false
+	}
+	zenity.Info("The Markdown is on the clipboard.", zenity.Title("Moka"))
+	return true
+}
 
-	// Clean the hostname to make it a valid filename
-	hostname = strings.ReplaceAll(hostname, ".", "-")
-	hostname = strings.ReplaceAll(hostname, ":", "-")
-
-	// Add timestamp for uniqueness
-	timestamp := time.Now().Format("20060102-150405")
-
-	return filepath.Join(downloadsDir, hostname+"-"+timestamp+".md")
+// generateFilename fills the name template for the page and picks a free
+// name in dir, adding -2, -3... when the file exists
+func generateFilename(dir, tmpl, url, title string) string {
+	name := filename.FromTemplate(tmpl, filename.Fields{Title: title, URL: url, Time: time.Now()})
+	return filename.Unique(filepath.Join(dir, name))
 }
 
-func convertURL(url string, filename string) {
+// convertURL converts the page at url and saves it to the path dest returns
+// for the page title, reporting errors in dialogs. It returns the path, the
+// title and whether the file was written.
+func convertURL(url string, dest func(title string) string) (string, string, bool) {
 	if url == "" {
 		zenity.Error("No URL provided")
-		return
+		return "", "", false
+	}
+	profile, err := convert.Lookup(*profileName, *configPath)
+	if err != nil {
+		zenity.Error("Error loading profile: " + err.Error())
+		return "", "", false
+	}
+	registry, err := sites.Load(*sitesPath)
+	if err != nil {
+		zenity.Error("Error loading site rules: " + err.Error())
+		return "", "", false
 	}
 
 	// Show progress dialog
@@ -98,53 +216,38 @@ func convertURL(url string, filename string) {
 	progress.Value(10)
 
 	// Fetch the URL content
-	cmdCurl := exec.Command("curl", "--no-progress-meter", url)
-	output, err := cmdCurl.CombinedOutput()
+	page, err := fetch.New().Fetch(context.Background(), url)
 	if err != nil {
 		progress.Close()
-		zenity.Error("Error during the CURL process: " + err.Error())
-		return
+		zenity.Error("Error fetching the page: " + err.Error())
+		return "", "", false
 	}
 
 	progress.Text("Converting to Markdown...")
 	progress.Value(50)
 
-	// Convert HTML to Markdown
-	conv := converter.NewConverter(
-		converter.WithPlugins(
-			base.NewBasePlugin(),
-			commonmark.NewCommonmarkPlugin(
-				commonmark.WithStrongDelimiter("**"),
-				commonmark.WithBulletListMarker("-"),
-				commonmark.WithCodeBlockFence("```"),
-				commonmark.WithHeadingStyle(commonmark.HeadingStyleATX),
-				commonmark.WithLinkEmptyContentBehavior(commonmark.LinkBehaviorSkip),
-				commonmark.WithLinkEmptyHrefBehavior(commonmark.LinkBehaviorSkip),
-			),
-			table.NewTablePlugin(
-				table.WithHeaderPromotion(true),
-				table.WithSkipEmptyRows(true),
-				table.WithSpanCellBehavior(table.SpanBehaviorEmpty),
-			),
-		),
-	)
-
-	markdown, err := conv.ConvertString(string(output))
+	markdown, md, err := convert.Page(url, page, convert.PageOptions{
+		Profile:  profile,
+		Sites:    registry,
+		FullPage: *fullPage,
+	})
 	if err != nil {
 		progress.Close()
 		zenity.Error("Error converting HTML to Markdown: " + err.Error())
-		return
+		return "", "", false
 	}
+	markdown = md.FrontMatter(markdown)
 
 	progress.Text("Saving file...")
 	progress.Value(90)
+	filename := dest(md.Title)
 
-	// Ensure the Downloads directory exists
+	// Ensure the output directory exists
 	dir := filepath.Dir(filename)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		progress.Close()
 		zenity.Error("Error creating directory: " + err.Error())
-		return
+		return "", "", false
 	}
 
 	// Write to file
@@ -152,7 +255,7 @@ func convertURL(url string, filename string) {
 	if err != nil {
 		progress.Close()
 		zenity.Error("Error writing file: " + err.Error())
-		return
+		return "", "", false
 	}
 
 	progress.Value(100)
@@ -160,4 +263,5 @@ func convertURL(url string, filename string) {
 
 	// Show success message with file location
 	zenity.Info("Conversion completed. File saved to:\n" + filename)
+	return filename, md.Title, true
 }
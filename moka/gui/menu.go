@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ncruces/zenity"
+)
+
+// Labels of the menu shown from the URL dialog's extra button
+const (
+	menuHistory   = "Conversion history"
+	menuOutputDir = "Change output folder"
+	menuTemplate  = "Change file name template"
+)
+
+// showMenu offers the history and settings. It returns false when the user
+// closed the menu to quit rather than go back to the URL dialog.
+func showMenu(prefs *settings, hist *history) bool {
+	dir, _ := prefs.outputDir()
+	items := []string{
+		menuHistory,
+		menuOutputDir + " (" + dir + ")",
+		menuTemplate + " (" + prefs.filenameTemplate() + ")",
+	}
+	choice, err := zenity.List("Moka", items,
+		zenity.Title("Moka"),
+		zenity.Width(500),
+		zenity.Height(250),
+		zenity.OKLabel("Open"),
+		zenity.CancelLabel("Back"),
+		zenity.WindowIcon("icon.png"),
+	)
+	if errors.Is(err, zenity.ErrCanceled) {
+		return true
+	}
+	if err != nil {
+		zenity.Error("Failed to show the menu: " + err.Error())
+		return false
+	}
+	switch choice {
+	case items[0]:
+		showHistory(prefs, hist)
+	case items[1]:
+		chooseOutputDir(prefs)
+	case items[2]:
+		chooseTemplate(prefs)
+	}
+	return true
+}
+
+// showHistory lists past conversions and acts on the chosen one
+func showHistory(prefs *settings, hist *history) {
+	if len(hist.Entries) == 0 {
+		zenity.Info("No conversions yet.", zenity.Title("Moka - History"))
+		return
+	}
+	items := make([]string, len(hist.Entries))
+	byItem := map[string]historyEntry{}
+	for i, e := range hist.Entries {
+		name := e.Title
+		if name == "" {
+			name = e.URL
+		}
+		items[i] = fmt.Sprintf("%d. %s  ·  %s  ·  %s", i+1, name, e.Converted.Local().Format("2006-01-02 15:04"), filepath.Base(e.Path))
+		byItem[items[i]] = e
+	}
+	choice, err := zenity.List("Past conversions, newest first", items,
+		zenity.Title("Moka - History"),
+		zenity.Width(700),
+		zenity.Height(450),
+		zenity.OKLabel("Select"),
+		zenity.CancelLabel("Back"),
+		zenity.DisallowEmpty(),
+	)
+	if err != nil || choice == "" {
+		return
+	}
+	entry := byItem[choice]
+
+	action, err := zenity.List(entry.URL+"\n"+entry.Path, []string{"Open", "Re-convert", "Delete"},
+		zenity.Title("Moka - History"),
+		zenity.Width(500),
+		zenity.Height(250),
+		zenity.CancelLabel("Back"),
+		zenity.DisallowEmpty(),
+	)
+	if err != nil {
+		return
+	}
+	switch action {
+	case "Open":
+		if err := openFile(entry.Path); err != nil {
+			zenity.Error("Could not open " + entry.Path + ": " + err.Error())
+		}
+	case "Re-convert":
+		path, title, ok := convertURL(entry.URL, func(string) string { return entry.Path })
+		if ok {
+			recordConversion(hist, entry.URL, path, title)
+		}
+	case "Delete":
+		if zenity.Question("Delete "+entry.Path+"?", zenity.Title("Moka - History"), zenity.OKLabel("Delete"), zenity.DefaultCancel()) != nil {
+			return
+		}
+		if err := os.Remove(entry.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			zenity.Error("Could not delete the file: " + err.Error())
+			return
+		}
+		if err := hist.Remove(entry.Path); err != nil {
+			zenity.Error("Could not update the history: " + err.Error())
+		}
+	}
+}
+
+// chooseOutputDir asks for the folder new conversions are saved to
+func chooseOutputDir(prefs *settings) {
+	current, _ := prefs.outputDir()
+	dir, err := zenity.SelectFile(
+		zenity.Title("Moka - Output folder"),
+		zenity.Directory(),
+		zenity.Filename(current+string(filepath.Separator)),
+	)
+	if err != nil || dir == "" {
+		return
+	}
+	prefs.OutputDir = dir
+	if err := prefs.save(); err != nil {
+		zenity.Error("Could not save the settings: " + err.Error())
+	}
+}
+
+// chooseTemplate asks for the template new files are named with
+func chooseTemplate(prefs *settings) {
+	tmpl, err := zenity.Entry("File name template, using {slug} (from the page title), {host}, {date} and {time}",
+		zenity.Title("Moka - File names"),
+		zenity.Width(500),
+		zenity.EntryText(prefs.filenameTemplate()),
+	)
+	if err != nil {
+		return
+	}
+	tmpl = strings.TrimSpace(tmpl)
+	if tmpl != "" && !strings.Contains(tmpl, "{") {
+		zenity.Error("The template needs at least one placeholder, e.g. {slug}.md")
+		return
+	}
+	prefs.FilenameTemplate = tmpl
+	if err := prefs.save(); err != nil {
+		zenity.Error("Could not save the settings: " + err.Error())
+	}
+}
+
+// recordConversion adds a finished conversion to the history
+func recordConversion(hist *history, url, path, title string) {
+	err := hist.Add(historyEntry{URL: url, Path: path, Title: title, Converted: time.Now()})
+	if err != nil {
+		zenity.Error("Could not update the history: " + err.Error())
+	}
+}
+
+// openFile opens path in the application the desktop associates with it
+func openFile(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+	case "darwin":
+		cmd = exec.Command("open", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+	return cmd.Start()
+}
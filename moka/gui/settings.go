@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"limpdev/moka/filename"
+)
+
+// settings are the GUI preferences kept between runs
+type settings struct {
+	path             string
+	OutputDir        string `json:"output_dir,omitempty"`        // Defaults to ~/Downloads
+	FilenameTemplate string `json:"filename_template,omitempty"` // Defaults to filename.DefaultTemplate
+}
+
+// configFile returns the path of a file in moka's config directory,
+// e.g. ~/.config/moka/history.json
+func configFile(name string) string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "moka", name)
+}
+
+// loadSettings reads the settings file; a missing file means the defaults
+func loadSettings(path string) (*settings, error) {
+	s := &settings{path: path}
+	if err := readJSON(path, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *settings) save() error {
+	return writeJSON(s.path, s)
+}
+
+// outputDir returns the configured output directory, or ~/Downloads
+func (s *settings) outputDir() (string, error) {
+	if s.OutputDir != "" {
+		return s.OutputDir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, "Downloads"), nil
+}
+
+// filenameTemplate returns the configured name template, or the default
+func (s *settings) filenameTemplate() string {
+	if s.FilenameTemplate != "" {
+		return s.FilenameTemplate
+	}
+	return filename.DefaultTemplate
+}
+
+func readJSON(path string, v any) error {
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func writeJSON(path string, v any) error {
+	if path == "" {
+		return errors.New("no config directory to save to")
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
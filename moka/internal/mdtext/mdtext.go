@@ -0,0 +1,17 @@
+// Package mdtext holds text helpers shared by moka's custom renderers.
+package mdtext
+
+import (
+	"regexp"
+	"strings"
+)
+
+// blankLinesRe matches runs of blank lines left between rendered blocks
+var blankLinesRe = regexp.MustCompile(`\n\s*\n(\s*\n)+`)
+
+// CollapseBlankLines trims the rendered children of a block and collapses
+// the runs of blank lines between them to one, ready to be prefixed or
+// indented line by line
+func CollapseBlankLines(s string) string {
+	return strings.TrimSpace(blankLinesRe.ReplaceAllString(s, "\n\n"))
+}
@@ -0,0 +1,152 @@
+// Package links rewrites relative URLs in an HTML document to absolute ones,
+// so links and images keep working once the Markdown leaves the browser.
+package links
+
+import (
+	"bytes"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/JohannesKaufmann/dom"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// urlAttrs lists the attributes holding a single URL, per element
+var urlAttrs = map[atom.Atom][]string{
+	atom.A:          {"href"},
+	atom.Area:       {"href"},
+	atom.Link:       {"href"},
+	atom.Img:        {"src", "data-src", "longdesc"},
+	atom.Source:     {"src"},
+	atom.Video:      {"src", "poster"},
+	atom.Audio:      {"src"},
+	atom.Track:      {"src"},
+	atom.Iframe:     {"src"},
+	atom.Embed:      {"src"},
+	atom.Object:     {"data"},
+	atom.Q:          {"cite"},
+	atom.Blockquote: {"cite"},
+	atom.Ins:        {"cite"},
+	atom.Del:        {"cite"},
+}
+
+// srcsetAttrs hold comma-separated "URL descriptor" candidate lists
+var srcsetAttrs = []string{"srcset", "data-srcset"}
+
+// Resolve parses document and makes every link and media URL absolute,
+// relative to pageURL or the document's <base href> when present.
+// Fragment-only links ("#section") and non-hierarchical URLs such as
+// mailto: and data: are left as they are.
+func Resolve(document, pageURL string) (string, error) {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return "", err
+	}
+	doc, err := html.Parse(strings.NewReader(document))
+	if err != nil {
+		return "", err
+	}
+
+	base = documentBase(doc, base)
+	for _, n := range dom.AllNodes(doc) {
+		if n.Type != html.ElementNode {
+			continue
+		}
+		for i, a := range n.Attr {
+			switch {
+			case slices.Contains(urlAttrs[n.DataAtom], a.Key):
+				n.Attr[i].Val = resolveURL(base, a.Val)
+			case slices.Contains(srcsetAttrs, a.Key):
+				n.Attr[i].Val = resolveSrcset(base, a.Val)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Collect returns the absolute http(s) targets of the <a> and <area> links
+// in document, without fragments, each listed once in document order
+func Collect(document, pageURL string) ([]string, error) {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := html.Parse(strings.NewReader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	base = documentBase(doc, base)
+	var targets []string
+	seen := map[string]bool{}
+	for _, n := range dom.AllNodes(doc) {
+		if n.Type != html.ElementNode || (n.DataAtom != atom.A && n.DataAtom != atom.Area) {
+			continue
+		}
+		for _, a := range n.Attr {
+			if a.Key != "href" {
+				continue
+			}
+			u, err := base.Parse(strings.TrimSpace(a.Val))
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+				continue
+			}
+			u.Fragment, u.RawFragment = "", ""
+			if target := u.String(); !seen[target] {
+				seen[target] = true
+				targets = append(targets, target)
+			}
+		}
+	}
+	return targets, nil
+}
+
+// documentBase applies the first <base href> to the page URL, as browsers do
+func documentBase(doc *html.Node, pageURL *url.URL) *url.URL {
+	for _, n := range dom.AllNodes(doc) {
+		if n.Type != html.ElementNode || n.DataAtom != atom.Base {
+			continue
+		}
+		if href, ok := dom.GetAttribute(n, "href"); ok {
+			if u, err := url.Parse(strings.TrimSpace(href)); err == nil {
+				return pageURL.ResolveReference(u)
+			}
+		}
+	}
+	return pageURL
+}
+
+// resolveURL makes ref absolute against base, leaving fragment-only and
+// opaque references (mailto:, javascript:, data:) untouched
+func resolveURL(base *url.URL, ref string) string {
+	trimmed := strings.TrimSpace(ref)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return ref
+	}
+	u, err := url.Parse(trimmed)
+	if err != nil || u.Opaque != "" || (u.Scheme != "" && u.Scheme != "http" && u.Scheme != "https") {
+		return ref
+	}
+	return base.ResolveReference(u).String()
+}
+
+// resolveSrcset resolves each candidate URL of a srcset value
+func resolveSrcset(base *url.URL, srcset string) string {
+	candidates := strings.Split(srcset, ",")
+	for i, c := range candidates {
+		fields := strings.Fields(c)
+		if len(fields) == 0 {
+			continue
+		}
+		fields[0] = resolveURL(base, fields[0])
+		candidates[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(candidates, ", ")
+}
@@ -1,65 +1,286 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"os"
-	"os/exec"
+	"path/filepath"
+	"strings"
 
-	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
-	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/base"
-	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/commonmark"
-	"github.com/JohannesKaufmann/html-to-markdown/v2/plugin/table"
+	"limpdev/moka/assets"
+	"limpdev/moka/bundle"
+	"limpdev/moka/convert"
+	"limpdev/moka/fetch"
+	"limpdev/moka/sites"
+)
+
+var (
+	fullPage  = flag.Bool("full-page", false, "Convert the whole page instead of only the main content")
+	assetsDir = flag.String("assets", "", "Download images into `DIR` and link them locally")
+	profile   = flag.String("profile", convert.DefaultProfile, "Markdown output profile `NAME` (default, github, obsidian, plain or one from --config)")
+	config    = flag.String("config", "", "Read profiles from `FILE` instead of "+convert.DefaultConfigPath())
+	sitesPath = flag.String("sites", "", "Read site rules from `FILE` instead of "+sites.DefaultConfigPath())
+	baseURL   = flag.String("base", "", "Resolve relative links against `URL` (defaults to the page URL, or the file's location)")
+	cacheDir  = flag.String("cache", fetch.DefaultCacheDir(), "Cache fetched pages in `DIR` and revalidate them on later runs")
+	noCache   = flag.Bool("no-cache", false, "Neither read nor write the fetch cache")
+	offline   = flag.Bool("offline", false, "Convert only from the fetch cache, without network access")
+	format    = flag.String("format", "markdown", "Output `FORMAT`: markdown, or bundle for a directory (or NAME.zip) holding the Markdown, images and metadata.json")
 )
 
 func main() {
-	Converter()
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka [--full-page] [--assets DIR] [--profile NAME] [--base URL] <URL|FILE.html|-> [NAME.md]")
+		fmt.Fprintln(os.Stderr, "       moka --format bundle <URL|FILE.html|-> <DIR|NAME.zip>")
+		fmt.Fprintln(os.Stderr, "       moka batch [flags] [URLS.txt]")
+		fmt.Fprintln(os.Stderr, "       moka crawl [flags] <URL>")
+		fmt.Fprintln(os.Stderr, "       moka watch [flags] [URLS.txt] [--interval 1h]")
+		fmt.Fprintln(os.Stderr, "       moka serve [--addr 127.0.0.1:8734]")
+		fmt.Fprintln(os.Stderr, "       moka render [flags] [NOTE.md] [-o NOTE.html]")
+		fmt.Fprintln(os.Stderr, "       moka unbundle <NAME.zip> [DIR]")
+		fmt.Fprintln(os.Stderr, "       moka cache clean [--all] [--max-age 720h] [--max-size 500]")
+		fmt.Fprintln(os.Stderr, "A local HTML file, or - for stdin, is converted like a fetched page")
+		fmt.Fprintln(os.Stderr, "If NAME.md is not provided, output will be written to stdout")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	switch flag.Arg(0) {
+	case "batch":
+		Batch(flag.Args()[1:])
+	case "crawl":
+		Crawl(flag.Args()[1:])
+	case "render":
+		Render(flag.Args()[1:])
+	case "unbundle":
+		Unbundle(flag.Args()[1:])
+	case "watch":
+		Watch(flag.Args()[1:])
+	case "serve":
+		Serve(flag.Args()[1:])
+	case "cache":
+		Cache(flag.Args()[1:])
+	default:
+		Converter()
+	}
 }
 
-func Converter() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: moka <URL> [NAME.md]")
-		fmt.Println("If NAME.md is not provided, output will be written to stdout")
-		os.Exit(1)
+// pageOptions control how a single page is converted
+type pageOptions struct {
+	Profile     convert.Profile
+	Sites       *sites.Registry // Site-specific rules, matched on the page's host
+	FullPage    bool            // Skip main content extraction
+	AssetsDir   string          // Download images here when set
+	MarkdownDir string          // Directory the Markdown is written to, for image links
+	BaseURL     string          // Resolve relative links against this instead of the page URL
+}
+
+// convertPage fetches a URL and returns its Markdown with front matter
+func convertPage(ctx context.Context, fetcher *fetch.Fetcher, input string, opts pageOptions) (string, error) {
+	page, err := fetcher.Fetch(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	return convertFetched(ctx, fetcher, input, page, opts)
+}
+
+// convertFetched converts a page that has already been fetched
+func convertFetched(ctx context.Context, fetcher *fetch.Fetcher, input string, page *fetch.Result, opts pageOptions) (string, error) {
+	markdown, md, err := convert.Page(input, page, convert.PageOptions{
+		Profile:  opts.Profile,
+		Sites:    opts.Sites,
+		FullPage: opts.FullPage,
+		BaseURL:  opts.BaseURL,
+	})
+	if err != nil {
+		return "", err
+	}
+	if opts.AssetsDir != "" {
+		markdown = localizeImages(ctx, fetcher, markdown, opts)
+	}
+	return md.FrontMatter(markdown), nil
+}
+
+// newFetcher returns a fetcher using the cache in dir, or exits when the
+// options contradict each other
+func newFetcher(dir string, noCache, offline bool) *fetch.Fetcher {
+	fetcher := fetch.New()
+	switch {
+	case offline && (noCache || dir == ""):
+		log.Fatal("--offline needs the fetch cache")
+	case !noCache && dir != "":
+		fetcher.Cache = &fetch.Cache{Dir: dir, Offline: offline}
+		// Offline runs only read, so they keep everything they may need
+		if !offline {
+			if _, err := fetcher.Cache.AutoClean(); err != nil {
+				log.Printf("Could not clean the fetch cache: %s", err)
+			}
+		}
+	}
+	return fetcher
+}
+
+// loadProfile looks up the named conversion profile or exits
+func loadProfile(name, configPath string) convert.Profile {
+	p, err := convert.Lookup(name, configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return p
+}
+
+// loadSites loads the site rules or exits
+func loadSites(path string) *sites.Registry {
+	reg, err := sites.Load(path)
+	if err != nil {
+		log.Fatal(err)
 	}
+	return reg
+}
 
-	input := os.Args[1]
-	cmdCurl := exec.Command("curl", "--no-progress-meter", input)
-	output, err := cmdCurl.CombinedOutput()
+// localizeImages downloads the images into opts.AssetsDir and links them
+// relative to opts.MarkdownDir
+func localizeImages(ctx context.Context, fetcher *fetch.Fetcher, markdown string, opts pageOptions) string {
+	dir, err := filepath.Abs(opts.AssetsDir)
+	if err != nil {
+		log.Printf("Could not download images: %s", err)
+		return markdown
+	}
+	markdownDir, err := filepath.Abs(opts.MarkdownDir)
+	if err != nil {
+		log.Printf("Could not download images: %s", err)
+		return markdown
+	}
+
+	markdown, report := assets.New(fetcher).Localize(ctx, markdown, dir, markdownDir)
+	for _, failure := range report.Failures {
+		log.Printf("Warning: could not download image %s", failure)
+	}
+	log.Printf("Saved %d images to %s (%d failed)", len(report.Saved), opts.AssetsDir, len(report.Failures))
+	return markdown
+}
+
+// convertInput converts a URL, or a local file or stdin for "-"
+func convertInput(ctx context.Context, fetcher *fetch.Fetcher, input string, opts pageOptions) (string, error) {
+	if strings.HasPrefix(input, "http://") || strings.HasPrefix(input, "https://") {
+		return convertPage(ctx, fetcher, input, opts)
+	}
+	page, err := fetch.Open(input)
 	if err != nil {
-		log.Fatalf("Error during the CURL process:\n%s", err)
-	}
-
-	conv := converter.NewConverter(
-		converter.WithPlugins(
-			base.NewBasePlugin(),
-			commonmark.NewCommonmarkPlugin(
-				commonmark.WithStrongDelimiter("**"),
-				commonmark.WithBulletListMarker("-"),
-				commonmark.WithCodeBlockFence("```"),
-				commonmark.WithHeadingStyle(commonmark.HeadingStyleATX),
-				commonmark.WithLinkEmptyContentBehavior(commonmark.LinkBehaviorSkip),
-				commonmark.WithLinkEmptyHrefBehavior(commonmark.LinkBehaviorSkip),
-				// ...additional configurations for the plugin
-			),
-			table.NewTablePlugin(
-				table.WithHeaderPromotion(true),
-				table.WithSkipEmptyRows(true),
-				table.WithSpanCellBehavior(table.SpanBehaviorEmpty),
-			// ...additional plugins (e.g. table)
-			),
-		),
-	)
-
-	markdown, err := conv.ConvertString(string(output))
+		return "", err
+	}
+	source := input
+	if input == "-" {
+		source = ""
+	}
+	return convertFetched(ctx, fetcher, source, page, opts)
+}
+
+// convertBundle converts input into a bundle at out, a directory or a .zip.
+// A zip is staged as a directory, with the images downloaded next to the
+// Markdown, in a temporary directory removed whether or not it succeeds.
+func convertBundle(ctx context.Context, fetcher *fetch.Fetcher, input, out string, opts pageOptions) error {
+	dir := out
+	if bundle.IsZip(out) {
+		tmp, err := os.MkdirTemp("", "moka-bundle-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	}
+	opts.AssetsDir = filepath.Join(dir, bundle.AssetsDir)
+	opts.MarkdownDir = dir
+
+	markdown, err := convertInput(ctx, fetcher, input, opts)
 	if err != nil {
+		return fmt.Errorf("converting the page: %w", err)
+	}
+	if err := bundle.WriteDir(dir, markdown); err != nil {
+		return fmt.Errorf("writing the bundle: %w", err)
+	}
+	if bundle.IsZip(out) {
+		if err := bundle.Zip(dir, out); err != nil {
+			return fmt.Errorf("writing the bundle: %w", err)
+		}
+	}
+	return nil
+}
+
+// Unbundle extracts a zipped bundle into a directory, by default one named
+// after the archive
+func Unbundle(args []string) {
+	if len(args) < 1 || len(args) > 2 {
+		fmt.Fprintln(os.Stderr, "Usage: moka unbundle <NAME.zip> [DIR]")
+		os.Exit(1)
+	}
+	dir := strings.TrimSuffix(args[0], filepath.Ext(args[0]))
+	if len(args) == 2 {
+		dir = args[1]
+	}
+	if err := bundle.Extract(args[0], dir); err != nil {
 		log.Fatal(err)
 	}
+	fmt.Println("Extracted to", dir)
+}
+
+func Converter() {
+	args := flag.Args()
+	if len(args) < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	opts := pageOptions{
+		Profile:     loadProfile(*profile, *config),
+		Sites:       loadSites(*sitesPath),
+		FullPage:    *fullPage,
+		AssetsDir:   *assetsDir,
+		MarkdownDir: ".",
+		BaseURL:     *baseURL,
+	}
+	if len(args) >= 2 {
+		opts.MarkdownDir = filepath.Dir(args[1])
+	}
+
+	switch *format {
+	case "markdown":
+	case "bundle":
+		if len(args) < 2 {
+			log.Fatal("--format bundle needs an output DIR or NAME.zip")
+		}
+		if opts.AssetsDir != "" {
+			log.Fatal("--assets cannot be used with --format bundle, which keeps images in the bundle")
+		}
+	default:
+		log.Fatalf("Unknown --format %q, use markdown or bundle", *format)
+	}
+	if opts.BaseURL != "" {
+		if u, err := url.Parse(opts.BaseURL); err != nil || !u.IsAbs() {
+			log.Fatalf("--base must be an absolute URL: %s", opts.BaseURL)
+		}
+	}
+
+	ctx := context.Background()
+	fetcher := newFetcher(*cacheDir, *noCache, *offline)
+	if *format == "bundle" {
+		if err := convertBundle(ctx, fetcher, args[0], args[1], opts); err != nil {
+			log.Fatalf("Could not create the bundle: %s", err)
+		}
+		fmt.Println("Done")
+		return
+	}
+
+	markdown, err := convertInput(ctx, fetcher, args[0], opts)
+	if err != nil {
+		log.Fatalf("Error converting the page:\n%s", err)
+	}
 
 	// Write to file if a filename is provided, otherwise write to stdout
-	if len(os.Args) >= 3 {
-		mdFile := os.Args[2]
+	if len(args) >= 2 {
+		mdFile := args[1]
 		err = os.WriteFile(mdFile, []byte(markdown), 0644)
 		if err != nil {
 			log.Fatal(err)
@@ -0,0 +1,259 @@
+// Package meta collects page metadata from <title>, OpenGraph and JSON-LD
+// and writes it as YAML front matter at the top of the Markdown output.
+package meta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JohannesKaufmann/dom"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Metadata describes where a Markdown file came from
+type Metadata struct {
+	Source      string    // URL as given by the user
+	FinalURL    string    // URL after redirects
+	Title       string    // JSON-LD headline, og:title or <title>
+	Author      string    // JSON-LD author, or author meta tags
+	SiteName    string    // og:site_name or JSON-LD publisher
+	Description string    // og:description, meta description or JSON-LD
+	Language    string    // <html lang>, og:locale or JSON-LD inLanguage
+	Published   string    // article:published_time or JSON-LD datePublished
+	Fetched     time.Time // When the page was downloaded
+	ContentHash string    // sha256 of the Markdown body, set by FrontMatter
+}
+
+// Parse reads the metadata of an HTML document. Source, FinalURL and Fetched
+// are left for the caller to fill in.
+func Parse(document string) Metadata {
+	var m Metadata
+	doc, err := html.Parse(strings.NewReader(document))
+	if err != nil {
+		return m
+	}
+
+	tags := map[string]string{} // meta name/property -> first content
+	var title string
+	var ld []map[string]any
+	for _, n := range dom.AllNodes(doc) {
+		if n.Type != html.ElementNode {
+			continue
+		}
+		switch n.DataAtom {
+		case atom.Html:
+			m.Language = dom.GetAttributeOr(n, "lang", "")
+		case atom.Title:
+			if title == "" && n.FirstChild != nil {
+				title = strings.TrimSpace(n.FirstChild.Data)
+			}
+		case atom.Meta:
+			key := strings.ToLower(dom.GetAttributeOr(n, "property", "") + dom.GetAttributeOr(n, "name", ""))
+			if _, ok := tags[key]; key != "" && !ok {
+				tags[key] = strings.TrimSpace(dom.GetAttributeOr(n, "content", ""))
+			}
+		case atom.Script:
+			if strings.EqualFold(dom.GetAttributeOr(n, "type", ""), "application/ld+json") && n.FirstChild != nil {
+				ld = append(ld, parseJSONLD(n.FirstChild.Data)...)
+			}
+		}
+	}
+
+	article := findArticle(ld)
+	m.Title = first(ldString(article["headline"]), tags["og:title"], tags["twitter:title"], title, ldString(article["name"]))
+	m.Author = first(ldName(article["author"]), tags["author"], tags["article:author"])
+	m.SiteName = first(tags["og:site_name"], ldName(article["publisher"]), tags["application-name"])
+	m.Description = first(tags["og:description"], tags["description"], ldString(article["description"]), tags["twitter:description"])
+	m.Language = first(m.Language, tags["og:locale"], ldString(article["inLanguage"]))
+	m.Published = first(tags["article:published_time"], ldString(article["datePublished"]), tags["date"])
+	return m
+}
+
+// FrontMatter returns markdown prefixed with a YAML front matter block.
+// The content hash is computed over markdown and stored in m.
+func (m *Metadata) FrontMatter(markdown string) string {
+	sum := sha256.Sum256([]byte(markdown))
+	m.ContentHash = "sha256:" + hex.EncodeToString(sum[:])
+
+	fetched := ""
+	if !m.Fetched.IsZero() {
+		fetched = m.Fetched.UTC().Format(time.RFC3339)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	for _, field := range []struct{ key, value string }{
+		{"source", m.Source},
+		{"url", m.FinalURL},
+		{"title", m.Title},
+		{"author", m.Author},
+		{"site_name", m.SiteName},
+		{"description", m.Description},
+		{"language", m.Language},
+		{"published", m.Published},
+		{"fetched", fetched},
+		{"content_hash", m.ContentHash},
+	} {
+		if field.value == "" {
+			continue
+		}
+		// Go's quoted strings are valid YAML double-quoted scalars
+		sb.WriteString(field.key + ": " + strconv.Quote(field.value) + "\n")
+	}
+	sb.WriteString("---\n\n")
+	sb.WriteString(markdown)
+	return sb.String()
+}
+
+// parseJSONLD decodes a JSON-LD script into its top-level objects,
+// flattening arrays and @graph containers
+func parseJSONLD(data string) []map[string]any {
+	var v any
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return nil
+	}
+	var objects []map[string]any
+	var collect func(any)
+	collect = func(v any) {
+		switch t := v.(type) {
+		case []any:
+			for _, item := range t {
+				collect(item)
+			}
+		case map[string]any:
+			objects = append(objects, t)
+			if graph, ok := t["@graph"]; ok {
+				collect(graph)
+			}
+		}
+	}
+	collect(v)
+	return objects
+}
+
+// articleTypes are the JSON-LD @type values that describe the page content
+var articleTypes = map[string]bool{
+	"Article":             true,
+	"BlogPosting":         true,
+	"NewsArticle":         true,
+	"TechArticle":         true,
+	"ScholarlyArticle":    true,
+	"Report":              true,
+	"WebPage":             true,
+	"AboutPage":           true,
+	"QAPage":              true,
+	"DiscussionForumPost": true,
+}
+
+// findArticle returns the JSON-LD object that best describes the page,
+// preferring article types over generic web pages
+func findArticle(objects []map[string]any) map[string]any {
+	var page map[string]any
+	for _, obj := range objects {
+		for _, t := range ldTypes(obj["@type"]) {
+			if !articleTypes[t] {
+				continue
+			}
+			if strings.HasSuffix(t, "Page") {
+				if page == nil {
+					page = obj
+				}
+				continue
+			}
+			return obj
+		}
+	}
+	if page != nil {
+		return page
+	}
+	return map[string]any{}
+}
+
+func ldTypes(v any) []string {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		var types []string
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func ldString(v any) string {
+	if s, ok := v.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return ""
+}
+
+// ldName reads a person or organization, given as a string, an object with
+// a name, or a list of either
+func ldName(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case map[string]any:
+		return ldString(t["name"])
+	case []any:
+		var names []string
+		for _, item := range t {
+			if name := ldName(item); name != "" {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	return ""
+}
+
+// first returns the first non-empty value
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// ReadFrontMatter splits a YAML front matter block off markdown, returning
+// its fields and the rest of the document. Only the flat "key: value" lines
+// FrontMatter writes are read; a document without front matter is returned
+// whole with no fields.
+func ReadFrontMatter(markdown string) (map[string]string, string) {
+	rest, ok := strings.CutPrefix(strings.TrimPrefix(markdown, "\ufeff"), "---\n")
+	if !ok {
+		return nil, markdown
+	}
+	block, body, ok := strings.Cut("\n"+rest, "\n---\n")
+	if !ok {
+		return nil, markdown
+	}
+
+	fields := map[string]string{}
+	for _, line := range strings.Split(block, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(line, " ") {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+		}
+		fields[strings.TrimSpace(key)] = value
+	}
+	return fields, strings.TrimLeft(body, "\n")
+}
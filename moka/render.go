@@ -0,0 +1,70 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"limpdev/moka/meta"
+	"limpdev/moka/render"
+)
+
+// Render turns a Markdown note (or stdin) into a self-contained HTML page
+func Render(args []string) {
+	fs := flag.NewFlagSet("render", flag.ExitOnError)
+	out := fs.String("o", "", "Write the HTML to `FILE` instead of stdout")
+	templatePath := fs.String("template", "", "Fill the HTML template `FILE` ({{TITLE}}, {{STYLE}}, {{CONTENT}}, {{SCRIPT}})")
+	title := fs.String("title", "", "Page title (defaults to the front matter title or the first heading)")
+	highlight := fs.Bool("highlight", true, "Inline Prism to highlight fenced code blocks")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka render [flags] [NOTE.md] [-o NOTE.html]")
+		fmt.Fprintln(os.Stderr, "The note is read from stdin when NOTE.md is omitted or -")
+		fs.PrintDefaults()
+	}
+
+	positional := parseInterleaved(fs, args)
+	if len(positional) > 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	var input io.Reader = os.Stdin
+	var path string
+	if len(positional) == 1 && positional[0] != "-" {
+		path = positional[0]
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		input = f
+	}
+	data, err := io.ReadAll(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tmpl, err := render.LoadTemplate(*templatePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fields, body := meta.ReadFrontMatter(string(data))
+	opts := render.Options{
+		Title:     cmp.Or(*title, fields["title"], render.Title(body, path)),
+		Template:  tmpl,
+		Highlight: *highlight,
+	}
+	page := render.Render(body, opts)
+
+	if *out == "" {
+		fmt.Print(page)
+		return
+	}
+	if err := os.WriteFile(*out, []byte(page), 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Wrote %s", *out)
+}
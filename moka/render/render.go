@@ -0,0 +1,96 @@
+// Package render turns Markdown notes back into self-contained HTML pages,
+// filling a template with the converted body and the repo's stylesheets.
+package render
+
+import (
+	_ "embed"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// DefaultTemplate is used when no template file is given. Templates are
+// plain HTML with the placeholders {{TITLE}}, {{STYLE}}, {{CONTENT}} and
+// {{SCRIPT}}; a template without {{STYLE}} or {{SCRIPT}} simply goes without.
+//
+//go:embed template.html
+var DefaultTemplate string
+
+var (
+	//go:embed assets/Main.css
+	mainCSS string
+	//go:embed assets/prismHL.css
+	prismCSS string
+	//go:embed assets/prismHL.js
+	prismJS string
+)
+
+// extensions are the blackfriday extensions matching moka's output:
+// tables, fenced code, strikethrough, footnotes and definition lists
+const extensions = blackfriday.CommonExtensions | blackfriday.Footnotes | blackfriday.AutoHeadingIDs
+
+// headingRe matches the first ATX heading, the fallback title
+var headingRe = regexp.MustCompile(`(?m)^#[ \t]+(.+?)[ \t#]*$`)
+
+// Options control how a note is rendered
+type Options struct {
+	Title     string // Page title, see Title
+	Template  string // Template source; defaults to DefaultTemplate
+	Highlight bool   // Inline Prism to highlight fenced code
+}
+
+// Render returns markdown as an HTML document. Split any front matter off
+// first with meta.ReadFrontMatter, or it is rendered as text.
+func Render(markdown string, opts Options) string {
+	body := blackfriday.Run([]byte(markdown), blackfriday.WithExtensions(extensions))
+
+	tmpl := opts.Template
+	if tmpl == "" {
+		tmpl = DefaultTemplate
+	}
+
+	style := mainCSS
+	var script string
+	if opts.Highlight {
+		style += "\n" + prismCSS
+		// A </script> inside the source would end the element early
+		script = "<script>" + strings.ReplaceAll(prismJS, "</script", `<\/script`) + "</script>"
+	}
+
+	return strings.NewReplacer(
+		"{{TITLE}}", html.EscapeString(opts.Title),
+		"{{STYLE}}", style,
+		"{{CONTENT}}", string(body),
+		"{{SCRIPT}}", script,
+	).Replace(tmpl)
+}
+
+// LoadTemplate reads a template file, or returns DefaultTemplate when path
+// is empty
+func LoadTemplate(path string) (string, error) {
+	if path == "" {
+		return DefaultTemplate, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Title returns the text of the note's first heading, or else the file name
+// of path without its extension
+func Title(markdown, path string) string {
+	if m := headingRe.FindStringSubmatch(markdown); m != nil {
+		return m[1]
+	}
+	if path == "" {
+		return ""
+	}
+	name := filepath.Base(path)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
@@ -0,0 +1,144 @@
+// Package robots parses robots.txt files and answers whether a crawler may
+// fetch a path, following RFC 9309 (longest match wins, * and $ wildcards).
+package robots
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rules are the robots.txt rules that apply to one user agent
+type Rules struct {
+	rules      []rule
+	CrawlDelay time.Duration // Zero when the file does not ask for one
+}
+
+type rule struct {
+	allow   bool
+	pattern string
+	re      *regexp.Regexp
+}
+
+// group is a set of user-agent lines followed by their rules
+type group struct {
+	agents     []string
+	rules      []rule
+	crawlDelay time.Duration
+}
+
+// AllowAll is used when a site has no robots.txt
+var AllowAll = &Rules{}
+
+// DisallowAll is used when robots.txt could not be fetched because of a
+// server error, as RFC 9309 asks
+var DisallowAll = &Rules{rules: []rule{{allow: false, pattern: "/", re: compile("/")}}}
+
+// Parse reads a robots.txt file and returns the rules for agent, falling back
+// to the "*" group when no group names the agent
+func Parse(r io.Reader, agent string) *Rules {
+	var groups []*group
+	var current *group
+	inAgents := false // Consecutive user-agent lines share one group
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if !inAgents {
+				current = &group{}
+				groups = append(groups, current)
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+			inAgents = true
+		case "allow", "disallow":
+			inAgents = false
+			if current == nil || value == "" {
+				continue
+			}
+			current.rules = append(current.rules, rule{allow: key == "allow", pattern: value, re: compile(value)})
+		case "crawl-delay":
+			inAgents = false
+			if current == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		default:
+			inAgents = false
+		}
+	}
+
+	agent = strings.ToLower(agent)
+	matched := &Rules{}
+	found := false
+	for _, want := range []string{agent, "*"} {
+		for _, g := range groups {
+			for _, a := range g.agents {
+				if a == want {
+					matched.rules = append(matched.rules, g.rules...)
+					matched.CrawlDelay = max(matched.CrawlDelay, g.crawlDelay)
+					found = true
+				}
+			}
+		}
+		if found {
+			break
+		}
+	}
+	return matched
+}
+
+// Allowed reports whether path (with its query, if any) may be fetched
+func (r *Rules) Allowed(path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+	best := -1
+	allowed := true
+	for _, rule := range r.rules {
+		if !rule.re.MatchString(path) {
+			continue
+		}
+		// The longest pattern wins; on a tie allow wins
+		if n := len(rule.pattern); n > best || (n == best && rule.allow) {
+			best = n
+			allowed = rule.allow
+		}
+	}
+	return allowed
+}
+
+// compile turns a robots.txt path pattern into an anchored regexp
+func compile(pattern string) *regexp.Regexp {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i, part := range strings.Split(pattern, "*") {
+		if i > 0 {
+			sb.WriteString(".*")
+		}
+		sb.WriteString(regexp.QuoteMeta(part))
+	}
+	if anchored {
+		sb.WriteString("$")
+	}
+	return regexp.MustCompile(sb.String())
+}
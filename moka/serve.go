@@ -0,0 +1,209 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"limpdev/moka/convert"
+	"limpdev/moka/fetch"
+	"limpdev/moka/meta"
+	"limpdev/moka/sites"
+)
+
+// convertRequest is the JSON body of POST /convert. Either URL or HTML is
+// required; with both, HTML is converted as the page found at URL, e.g. a
+// page captured by a browser extension.
+type convertRequest struct {
+	URL         string `json:"url"`
+	HTML        string `json:"html"`
+	BaseURL     string `json:"base_url"`     // Resolve relative links against this instead
+	Profile     string `json:"profile"`      // Defaults to the server's --profile
+	FullPage    bool   `json:"full_page"`    // Skip main content extraction
+	FrontMatter bool   `json:"front_matter"` // Keep the YAML front matter in the Markdown
+}
+
+// convertResponse is returned by POST /convert
+type convertResponse struct {
+	Markdown string            `json:"markdown"`
+	Metadata map[string]string `json:"metadata"` // The front matter fields: title, author, url...
+}
+
+// server answers conversion requests with shared fetcher and site rules
+type server struct {
+	fetcher    *fetch.Fetcher
+	sites      *sites.Registry
+	profile    string
+	configPath string
+	maxBody    int64
+}
+
+// Serve runs a local HTTP API so other programs can convert pages without
+// starting moka for each one
+func Serve(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", "127.0.0.1:8734", "Listen on `HOST:PORT`")
+	maxBody := fs.Int64("max-body", 10<<20, "Reject request bodies over `BYTES`")
+	profileName := fs.String("profile", *profile, "Default Markdown output profile `NAME`")
+	configPath := fs.String("config", *config, "Read profiles from `FILE`")
+	sitesFile := fs.String("sites", *sitesPath, "Read site rules from `FILE`")
+	cache := fs.String("cache", *cacheDir, "Cache fetched pages in `DIR`")
+	skipCache := fs.Bool("no-cache", *noCache, "Neither read nor write the fetch cache")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka serve [--addr 127.0.0.1:8734] [flags]")
+		fmt.Fprintln(os.Stderr, `POST /convert with Content-Type application/json {"url": "...", "html": "...", "profile": "github"} returns {"markdown": "...", "metadata": {...}}`)
+		fs.PrintDefaults()
+	}
+	if positional := parseInterleaved(fs, args); len(positional) > 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	// Fail at startup rather than on the first request
+	loadProfile(*profileName, *configPath)
+	s := &server{
+		fetcher:    newFetcher(*cache, *skipCache, false),
+		sites:      loadSites(*sitesFile),
+		profile:    *profileName,
+		configPath: *configPath,
+		maxBody:    *maxBody,
+	}
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           s.routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+		WriteTimeout:      2 * time.Minute,
+	}
+	log.Printf("Listening on http://%s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /convert", s.handleConvert)
+	return mux
+}
+
+func (s *server) handleConvert(w http.ResponseWriter, r *http.Request) {
+	// Browsers send cross-site form and text/plain posts without a
+	// preflight, so a page the user visits could have moka fetch internal
+	// URLs: only same-origin JSON requests get through
+	if !sameOrigin(r) {
+		writeError(w, http.StatusForbidden, "cross-origin requests are not allowed")
+		return
+	}
+	if ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); ct != "application/json" {
+		writeError(w, http.StatusUnsupportedMediaType, "Content-Type must be application/json")
+		return
+	}
+
+	var req convertRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body over %d bytes", tooLarge.Limit))
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid JSON: "+err.Error())
+		return
+	}
+
+	if req.URL == "" && req.HTML == "" {
+		writeError(w, http.StatusBadRequest, `"url" or "html" is required`)
+		return
+	}
+	for _, u := range []string{req.URL, req.BaseURL} {
+		if u != "" && !isHTTPURL(u) {
+			writeError(w, http.StatusBadRequest, "not an http(s) URL: "+u)
+			return
+		}
+	}
+	p, err := convert.Lookup(cmp.Or(req.Profile, s.profile), s.configPath)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	opts := pageOptions{
+		Profile:  p,
+		Sites:    s.sites,
+		FullPage: req.FullPage,
+		BaseURL:  req.BaseURL,
+	}
+
+	markdown, err := s.convert(r.Context(), req, opts)
+	if err != nil {
+		status := http.StatusBadGateway
+		var statusErr *fetch.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err.Error())
+		return
+	}
+
+	fields, body := meta.ReadFrontMatter(markdown)
+	if req.FrontMatter {
+		body = markdown
+	}
+	writeJSON(w, http.StatusOK, convertResponse{Markdown: body, Metadata: fields})
+}
+
+// convert fetches the requested URL, or wraps the posted HTML as if it had
+// been fetched from it, and converts the page
+func (s *server) convert(ctx context.Context, req convertRequest, opts pageOptions) (string, error) {
+	if req.HTML == "" {
+		return convertPage(ctx, s.fetcher, req.URL, opts)
+	}
+	page := &fetch.Result{
+		URL:         req.URL,
+		FinalURL:    req.URL,
+		StatusCode:  http.StatusOK,
+		Header:      http.Header{},
+		ContentType: "text/html",
+		Charset:     "utf-8",
+		Body:        []byte(req.HTML),
+		Fetched:     time.Now(),
+	}
+	return convertFetched(ctx, s.fetcher, req.URL, page, opts)
+}
+
+// sameOrigin reports whether r has no Origin header, as with curl and other
+// non-browser clients, or one naming the server itself
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && isHTTPURL(origin) && strings.EqualFold(u.Host, r.Host)
+}
+
+func isHTTPURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Could not write response: %s", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": strings.TrimSpace(message)})
+}
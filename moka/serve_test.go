@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"limpdev/moka/convert"
+	"limpdev/moka/fetch"
+	"limpdev/moka/sites"
+)
+
+// newTestServer returns the API of a server without a fetch cache, reading
+// profiles and site rules from empty configs so user files don't interfere
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	dir := t.TempDir()
+	profiles := filepath.Join(dir, "profiles.json")
+	sitesFile := filepath.Join(dir, "sites.json")
+	for _, name := range []string{profiles, sitesFile} {
+		if err := os.WriteFile(name, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	registry, err := sites.Load(sitesFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &server{
+		fetcher:    fetch.New(),
+		sites:      registry,
+		profile:    convert.DefaultProfile,
+		configPath: profiles,
+		maxBody:    1 << 10,
+	}
+	api := httptest.NewServer(s.routes())
+	t.Cleanup(api.Close)
+	return api
+}
+
+// post sends body to /convert and decodes the JSON response into v
+func post(t *testing.T, api *httptest.Server, body string, v any) int {
+	t.Helper()
+	resp, err := http.Post(api.URL+"/convert", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("decoding response: %s", err)
+	}
+	return resp.StatusCode
+}
+
+func TestServeConvertHTML(t *testing.T) {
+	api := newTestServer(t)
+	req := `{"url": "https://example.com/post/", "full_page": true,
+		"html": "<html><head><title>Hello</title></head><body><h1>Hello</h1><p>See <a href=\"../about\">about</a>.</p></body></html>"}`
+
+	var got convertResponse
+	if status := post(t, api, req, &got); status != http.StatusOK {
+		t.Fatalf("status = %d, want 200", status)
+	}
+	if strings.HasPrefix(got.Markdown, "---") {
+		t.Errorf("Markdown starts with front matter without front_matter set:\n%s", got.Markdown)
+	}
+	if !strings.Contains(got.Markdown, "# Hello") {
+		t.Errorf("Markdown misses the heading:\n%s", got.Markdown)
+	}
+	if !strings.Contains(got.Markdown, "[about](https://example.com/about)") {
+		t.Errorf("Markdown misses the resolved link:\n%s", got.Markdown)
+	}
+	if got.Metadata["title"] != "Hello" || got.Metadata["url"] != "https://example.com/post/" {
+		t.Errorf("Metadata = %v, want the title and url", got.Metadata)
+	}
+
+	req = `{"html": "<p>Kept</p>", "full_page": true, "front_matter": true}`
+	if status := post(t, api, req, &got); status != http.StatusOK {
+		t.Fatalf("status = %d, want 200", status)
+	}
+	if !strings.HasPrefix(got.Markdown, "---\n") || !strings.Contains(got.Markdown, "Kept") {
+		t.Errorf("Markdown with front_matter:\n%s", got.Markdown)
+	}
+}
+
+func TestServeConvertURL(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/page" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, "<html><head><title>Fetched</title></head><body><p>From upstream</p></body></html>")
+	}))
+	defer upstream.Close()
+	api := newTestServer(t)
+
+	var got convertResponse
+	if status := post(t, api, `{"url": "`+upstream.URL+`/page", "full_page": true}`, &got); status != http.StatusOK {
+		t.Fatalf("status = %d, want 200", status)
+	}
+	if !strings.Contains(got.Markdown, "From upstream") || got.Metadata["title"] != "Fetched" {
+		t.Errorf("response = %+v, want the upstream page", got)
+	}
+
+	var failed map[string]string
+	if status := post(t, api, `{"url": "`+upstream.URL+`/missing"}`, &failed); status != http.StatusNotFound {
+		t.Errorf("upstream 404: status = %d, want 404 (%v)", status, failed)
+	}
+}
+
+func TestServeConvertErrors(t *testing.T) {
+	api := newTestServer(t)
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"invalid JSON", `{"url": `, http.StatusBadRequest},
+		{"unknown field", `{"url": "https://example.com/", "depth": 2}`, http.StatusBadRequest},
+		{"missing url and html", `{"profile": "github"}`, http.StatusBadRequest},
+		{"non-http URL", `{"url": "file:///etc/passwd"}`, http.StatusBadRequest},
+		{"non-http base URL", `{"html": "<p>x</p>", "base_url": "ftp://example.com/"}`, http.StatusBadRequest},
+		{"unknown profile", `{"html": "<p>x</p>", "profile": "nope"}`, http.StatusBadRequest},
+		{"body over the limit", `{"html": "` + strings.Repeat("x", 2<<10) + `"}`, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got map[string]string
+			if status := post(t, api, tt.body, &got); status != tt.status {
+				t.Errorf("status = %d, want %d (%v)", status, tt.status, got)
+			}
+			if got["error"] == "" {
+				t.Errorf("response %v has no error message", got)
+			}
+		})
+	}
+}
+
+func TestServeMethodNotAllowed(t *testing.T) {
+	api := newTestServer(t)
+	resp, err := http.Get(api.URL + "/convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET /convert: status = %d, want 405", resp.StatusCode)
+	}
+}
+
+func TestServeCrossSite(t *testing.T) {
+	api := newTestServer(t)
+	body := `{"html": "<p>x</p>", "full_page": true}`
+	tests := []struct {
+		name        string
+		contentType string
+		origin      string
+		status      int
+	}{
+		{"text/plain body", "text/plain", "", http.StatusUnsupportedMediaType},
+		{"form body", "application/x-www-form-urlencoded", "", http.StatusUnsupportedMediaType},
+		{"no Content-Type", "", "", http.StatusUnsupportedMediaType},
+		{"other origin", "application/json", "https://evil.example", http.StatusForbidden},
+		{"opaque origin", "application/json", "null", http.StatusForbidden},
+		{"other origin as text/plain", "text/plain", "https://evil.example", http.StatusForbidden},
+		{"same origin", "application/json; charset=utf-8", api.URL, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, api.URL+"/convert", strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+		})
+	}
+}
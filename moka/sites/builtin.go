@@ -0,0 +1,69 @@
+package sites
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/JohannesKaufmann/dom"
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"limpdev/moka/internal/mdtext"
+)
+
+// builtin are the rules shipped with moka
+var builtin = []*Rule{
+	{
+		Name:    "github",
+		Hosts:   []string{"github.com"},
+		Content: "article.markdown-body, .markdown-body",
+		Remove:  []string{"a.anchor", ".octicon", "clipboard-copy", ".zeroclipboard-container"},
+	},
+	{
+		Name: "stackexchange",
+		Hosts: []string{"stackoverflow.com", "*.stackoverflow.com", "*.stackexchange.com",
+			"superuser.com", "serverfault.com", "askubuntu.com", "mathoverflow.net"},
+		Content: "#question-header h1, .question .js-post-body, .answer .js-post-body",
+		Remove:  []string{".js-post-menu", ".post-signature", ".js-comments-container", ".js-post-notices"},
+	},
+	{
+		Name:    "wikipedia",
+		Hosts:   []string{"*.wikipedia.org"},
+		Content: "#firstHeading, #mw-content-text .mw-parser-output",
+		Remove: []string{".mw-editsection", ".navbox", ".vertical-navbox", ".metadata",
+			".mw-jump-link", "#toc", ".toc", ".hatnote", ".noprint", ".mw-empty-elt", ".infobox"},
+	},
+	{
+		Name:     "mdn",
+		Hosts:    []string{"developer.mozilla.org"},
+		Content:  "main#content article, article.main-page-content",
+		Remove:   []string{".prev-next", ".metadata", ".example-header", ".copy-icon", ".bc-data", ".document-toc-container"},
+		Handlers: []string{"mdn-notecard"},
+	},
+}
+
+// Handlers are the custom renderers rules can refer to by name. Each returns
+// converter.RenderTryNext for nodes it does not handle.
+var Handlers = map[string]converter.HandleRenderFunc{
+	"mdn-notecard": renderNotecard,
+}
+
+// renderNotecard writes MDN's note, warning and callout boxes as blockquotes
+func renderNotecard(ctx converter.Context, w converter.Writer, n *html.Node) converter.RenderStatus {
+	if n.DataAtom != atom.Div || !dom.HasClass(n, "notecard") {
+		return converter.RenderTryNext
+	}
+	var buf bytes.Buffer
+	ctx.RenderChildNodes(ctx, &buf, n)
+	content := mdtext.CollapseBlankLines(buf.String())
+	if content == "" {
+		return converter.RenderSuccess
+	}
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	w.WriteString("\n\n" + strings.Join(lines, "\n") + "\n\n")
+	return converter.RenderSuccess
+}
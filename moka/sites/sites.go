@@ -0,0 +1,214 @@
+// Package sites holds per-site extraction rules for pages the generic
+// extractor handles badly: a CSS selector for the content root, selectors
+// for elements to strip, and custom renderers for site-specific markup.
+package sites
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/JohannesKaufmann/html-to-markdown/v2/converter"
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+)
+
+// Rule describes how to convert pages from a set of hosts
+type Rule struct {
+	Name     string   `json:"name"`
+	Hosts    []string `json:"hosts"`    // "example.com", or "*.example.com" for any subdomain
+	Content  string   `json:"content"`  // Selector for the content root; several matches are joined with <hr>
+	Remove   []string `json:"remove"`   // Selectors of elements to drop before conversion
+	Handlers []string `json:"handlers"` // Names of custom renderers, see Handlers
+
+	content cascadia.SelectorGroup
+	remove  []cascadia.SelectorGroup
+}
+
+// Registry finds the rule for a host. User rules take precedence over the
+// built-in ones.
+type Registry struct {
+	rules []*Rule
+}
+
+// DefaultConfigPath is where user rules are read from when no path is given,
+// e.g. ~/.config/moka/sites.json
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "moka", "sites.json")
+}
+
+// Load returns a registry with the rules from the config file at path (or
+// DefaultConfigPath when path is empty) ahead of the built-in rules. A missing
+// default config is not an error. The file looks like:
+//
+//	{"sites": [{"name": "blog", "hosts": ["blog.example.com"], "content": "div.post", "remove": [".share"]}]}
+func Load(path string) (*Registry, error) {
+	var rules []*Rule
+
+	explicit := path != ""
+	if !explicit {
+		path = DefaultConfigPath()
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, os.ErrNotExist) && !explicit:
+		case err != nil:
+			return nil, err
+		default:
+			var config struct {
+				Sites []*Rule `json:"sites"`
+			}
+			if err := json.Unmarshal(data, &config); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			for _, r := range config.Sites {
+				if err := r.compile(); err != nil {
+					return nil, fmt.Errorf("%s: %w", path, err)
+				}
+			}
+			rules = append(rules, config.Sites...)
+		}
+	}
+
+	for _, r := range builtin {
+		r := *r
+		if err := r.compile(); err != nil {
+			return nil, err
+		}
+		rules = append(rules, &r)
+	}
+	return &Registry{rules: rules}, nil
+}
+
+// Match returns the first rule for host, or nil
+func (reg *Registry) Match(host string) *Rule {
+	if reg == nil {
+		return nil
+	}
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	if h, _, ok := strings.Cut(host, ":"); ok {
+		host = h
+	}
+	for _, r := range reg.rules {
+		for _, pattern := range r.Hosts {
+			pattern = strings.ToLower(pattern)
+			if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+				if strings.HasSuffix(host, "."+suffix) {
+					return r
+				}
+			} else if host == strings.TrimPrefix(pattern, "www.") {
+				return r
+			}
+		}
+	}
+	return nil
+}
+
+// compile parses the selectors and checks the handler names
+func (r *Rule) compile() error {
+	if r.Name == "" || len(r.Hosts) == 0 {
+		return errors.New("site rules need a name and at least one host")
+	}
+	var err error
+	if r.Content != "" {
+		if r.content, err = cascadia.ParseGroup(r.Content); err != nil {
+			return fmt.Errorf("site %q: content selector: %w", r.Name, err)
+		}
+	}
+	r.remove = nil
+	for _, s := range r.Remove {
+		sel, err := cascadia.ParseGroup(s)
+		if err != nil {
+			return fmt.Errorf("site %q: remove selector %q: %w", r.Name, s, err)
+		}
+		r.remove = append(r.remove, sel)
+	}
+	for _, name := range r.Handlers {
+		if _, ok := Handlers[name]; !ok {
+			return fmt.Errorf("site %q: unknown handler %q", r.Name, name)
+		}
+	}
+	return nil
+}
+
+// Apply strips the removed elements from document and returns the cleaned
+// document along with the HTML of the content root, which is empty when the
+// rule has no content selector or it matched nothing
+func (r *Rule) Apply(document string) (cleaned, content string, err error) {
+	doc, err := html.Parse(strings.NewReader(document))
+	if err != nil {
+		return "", "", err
+	}
+	for _, sel := range r.remove {
+		for _, n := range cascadia.QueryAll(doc, sel) {
+			if n.Parent != nil {
+				n.Parent.RemoveChild(n)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if r.content != nil {
+		var roots []*html.Node
+		for _, n := range cascadia.QueryAll(doc, r.content) {
+			if !insideAny(n, roots) {
+				roots = append(roots, n)
+			}
+		}
+		for i, n := range roots {
+			if i > 0 {
+				buf.WriteString("<hr>")
+			}
+			if err := html.Render(&buf, n); err != nil {
+				return "", "", err
+			}
+		}
+		content = buf.String()
+		buf.Reset()
+	}
+	if err := html.Render(&buf, doc); err != nil {
+		return "", "", err
+	}
+	return buf.String(), content, nil
+}
+
+// Plugin returns an html-to-markdown plugin registering the rule's handlers
+func (r *Rule) Plugin() converter.Plugin {
+	return &rulePlugin{rule: r}
+}
+
+type rulePlugin struct {
+	rule *Rule
+}
+
+func (p *rulePlugin) Name() string {
+	return "site-" + p.rule.Name
+}
+
+func (p *rulePlugin) Init(conv *converter.Converter) error {
+	for _, name := range p.rule.Handlers {
+		conv.Register.Renderer(Handlers[name], converter.PriorityEarly)
+	}
+	return nil
+}
+
+// insideAny reports whether n is one of roots or a descendant of one
+func insideAny(n *html.Node, roots []*html.Node) bool {
+	for ; n != nil; n = n.Parent {
+		for _, r := range roots {
+			if n == r {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"limpdev/moka/diff"
+	"limpdev/moka/fetch"
+	"limpdev/moka/meta"
+)
+
+// Watch reconverts a list of pages on a schedule, keeping the latest and
+// previous Markdown of each and writing a unified diff whenever it changes
+func Watch(args []string) {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	interval := fs.Duration("interval", time.Hour, "Time between checks of every page")
+	out := fs.String("out", "watch", "Keep the Markdown and diffs in `DIR`")
+	once := fs.Bool("once", false, "Check every page once and exit, e.g. from cron")
+	delay := fs.Duration("delay", time.Second, "Minimum time between requests to the same host")
+	full := fs.Bool("full-page", false, "Convert the whole page instead of only the main content")
+	profileName := fs.String("profile", *profile, "Markdown output profile `NAME`")
+	configPath := fs.String("config", *config, "Read profiles from `FILE`")
+	sitesFile := fs.String("sites", *sitesPath, "Read site rules from `FILE`")
+	cache := fs.String("cache", *cacheDir, "Cache fetched pages in `DIR`")
+	skipCache := fs.Bool("no-cache", *noCache, "Neither read nor write the fetch cache")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: moka watch [flags] [URLS.txt]")
+		fmt.Fprintln(os.Stderr, "URLs are read one per line from URLS.txt, or stdin when it is omitted or -")
+		fmt.Fprintln(os.Stderr, "Each page is kept as DIR/HOST/PATH.md, its previous version as PATH.prev.md,")
+		fmt.Fprintln(os.Stderr, "and every change as PATH-YYYYMMDD-HHMMSS.diff")
+		fs.PrintDefaults()
+	}
+
+	positional := parseInterleaved(fs, args)
+	if len(positional) > 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+	var input io.Reader = os.Stdin
+	if len(positional) == 1 && positional[0] != "-" {
+		f, err := os.Open(positional[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		input = f
+	}
+	urls, err := readURLs(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(urls) == 0 {
+		log.Fatal("No URLs to watch")
+	}
+	if *interval <= 0 {
+		log.Fatal("--interval must be positive")
+	}
+
+	w := &watcher{
+		fetcher: newFetcher(*cache, *skipCache, false),
+		limiter: newHostLimiter(*delay),
+		out:     *out,
+		files:   watchFiles(urls),
+		opts: pageOptions{
+			Profile:  loadProfile(*profileName, *configPath),
+			Sites:    loadSites(*sitesFile),
+			FullPage: *full,
+		},
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	for {
+		w.check(ctx, urls)
+		if *once {
+			return
+		}
+		next := time.Now().Add(*interval)
+		fmt.Printf("Next check at %s\n", next.Format(time.TimeOnly))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+	}
+}
+
+// watcher holds what stays the same between checks
+type watcher struct {
+	fetcher *fetch.Fetcher
+	limiter *hostLimiter
+	out     string
+	files   map[string]string // URL -> path of its Markdown under out
+	opts    pageOptions
+}
+
+// watchFiles maps each URL to its Markdown file, relative to the output
+// directory. When URLs share a local path, like /a and /a.html, the URL that
+// sorts first keeps it and the others get a hash of their URL, so reordering
+// the list never moves a page's history to another file.
+func watchFiles(urls []string) map[string]string {
+	keys := map[string]string{}  // URL as listed -> normalized URL
+	paths := map[string]string{} // Normalized URL -> local path
+	groups := map[string][]string{}
+	for _, rawURL := range urls {
+		u, key, ok := normalizeURL(rawURL)
+		if !ok {
+			continue
+		}
+		keys[rawURL] = key
+		if _, ok := paths[key]; !ok {
+			paths[key] = localPath(u)
+			// Compare ignoring case, for case-insensitive file systems
+			group := strings.ToLower(paths[key])
+			groups[group] = append(groups[group], key)
+		}
+	}
+
+	names := map[string]string{}
+	for _, group := range groups {
+		slices.Sort(group)
+		for i, key := range group {
+			name := paths[key]
+			if i > 0 {
+				h := fnv.New32a()
+				h.Write([]byte(key))
+				name = fmt.Sprintf("%s-%08x.md", strings.TrimSuffix(name, ".md"), h.Sum32())
+			}
+			names[key] = name
+		}
+	}
+	files := map[string]string{}
+	for rawURL, key := range keys {
+		files[rawURL] = names[key]
+	}
+	return files
+}
+
+// check reconverts every page once, printing one line per page
+func (w *watcher) check(ctx context.Context, urls []string) {
+	var changed, failed int
+	for _, rawURL := range urls {
+		if ctx.Err() != nil {
+			return
+		}
+		status, err := w.checkOne(ctx, rawURL)
+		switch {
+		case err != nil:
+			failed++
+			fmt.Printf("FAIL %s: %s\n", rawURL, err)
+		case status != "":
+			changed++
+			fmt.Printf("%s\n", status)
+		}
+	}
+	fmt.Printf("Checked %d pages at %s: %d new or changed, %d failed\n",
+		len(urls), time.Now().Format(time.DateTime), changed, failed)
+}
+
+// checkOne converts a page and compares it with the kept version, ignoring
+// the front matter, which changes on every fetch. It returns a line to print
+// for new or changed pages, or "" when nothing changed.
+func (w *watcher) checkOne(ctx context.Context, rawURL string) (string, error) {
+	u, _, ok := normalizeURL(rawURL)
+	if !ok {
+		return "", errors.New("not an http(s) URL")
+	}
+	rel := w.files[rawURL]
+	file := filepath.Join(w.out, rel)
+
+	if err := w.limiter.Wait(ctx, u.Host); err != nil {
+		return "", err
+	}
+	opts := w.opts
+	opts.MarkdownDir = filepath.Dir(file)
+	markdown, err := convertPage(ctx, w.fetcher, rawURL, opts)
+	if err != nil {
+		return "", err
+	}
+
+	previous, err := os.ReadFile(file)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(file, []byte(markdown), 0644); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("NEW  %s -> %s", rawURL, file), nil
+	case err != nil:
+		return "", err
+	}
+
+	_, oldBody := meta.ReadFrontMatter(string(previous))
+	_, newBody := meta.ReadFrontMatter(markdown)
+	patch := diff.Unified("a/"+filepath.ToSlash(rel), "b/"+filepath.ToSlash(rel), oldBody, newBody, diff.DefaultContext)
+	if patch == "" {
+		return "", nil
+	}
+
+	base := strings.TrimSuffix(file, ".md")
+	diffFile := base + "-" + time.Now().Format("20060102-150405") + ".diff"
+	if err := os.WriteFile(diffFile, []byte(patch), 0644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(file, base+".prev.md"); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(file, []byte(markdown), 0644); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("DIFF %s -> %s", rawURL, diffFile), nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestWatchFilesStable(t *testing.T) {
+	urls := []string{
+		"https://example.com/a.html",
+		"https://example.com/b",
+		"https://example.com/a",
+		"https://example.com/a#intro",
+		"https://example.com/A",
+		"not a url",
+	}
+	files := watchFiles(urls)
+
+	reversed := slices.Clone(urls)
+	slices.Reverse(reversed)
+	if got := watchFiles(reversed); !maps.Equal(got, files) {
+		t.Errorf("reordered list maps to %q, want %q", got, files)
+	}
+
+	host := func(name string) string { return filepath.Join("example.com", name) }
+	hashed := regexp.MustCompile(`^a-[0-9a-f]{8}\.md$`)
+	if files["https://example.com/A"] != host("A.md") {
+		t.Errorf("/A -> %q, want the plain name as it sorts first", files["https://example.com/A"])
+	}
+	for _, u := range []string{"https://example.com/a", "https://example.com/a.html"} {
+		if name := filepath.Base(files[u]); filepath.Dir(files[u]) != "example.com" || !hashed.MatchString(name) {
+			t.Errorf("%s -> %q, want a hashed name", u, files[u])
+		}
+	}
+	if files["https://example.com/a"] == files["https://example.com/a.html"] {
+		t.Errorf("/a and /a.html share %q", files["https://example.com/a"])
+	}
+	if files["https://example.com/a#intro"] != files["https://example.com/a"] {
+		t.Errorf("/a#intro -> %q, want the file of /a", files["https://example.com/a#intro"])
+	}
+	if files["https://example.com/b"] != host("b.md") {
+		t.Errorf("/b -> %q, want %q", files["https://example.com/b"], host("b.md"))
+	}
+	if _, ok := files["not a url"]; ok {
+		t.Error("an invalid URL got a file")
+	}
+}
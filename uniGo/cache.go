@@ -0,0 +1,225 @@
+// cache.go
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+)
+
+const (
+	cacheMaxBytes    = 64 << 20 // Budget for serialized pages held in memory
+	compressMinBytes = 1 << 10  // Bodies smaller than this are sent as-is
+)
+
+// cachedResponse is a serialized 200 response with precompressed variants
+type cachedResponse struct {
+	key    string
+	etag   string
+	header http.Header
+	body   []byte
+	gzip   []byte // nil when the body is too small to compress
+	brotli []byte
+}
+
+func (c *cachedResponse) size() int {
+	return len(c.key) + len(c.body) + len(c.gzip) + len(c.brotli)
+}
+
+// responseCache is an LRU of serialized responses bounded by total bytes
+type responseCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	bytes    int
+	order    *list.List // Front is most recently used
+	entries  map[string]*list.Element
+}
+
+func newResponseCache(maxBytes int) *responseCache {
+	return &responseCache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (c *responseCache) get(key string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cachedResponse)
+}
+
+func (c *responseCache) add(entry *cachedResponse) {
+	if entry.size() > c.maxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[entry.key]; ok {
+		c.bytes -= elem.Value.(*cachedResponse).size()
+		c.order.Remove(elem)
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	c.bytes += entry.size()
+	for c.bytes > c.maxBytes {
+		oldest := c.order.Back()
+		evicted := c.order.Remove(oldest).(*cachedResponse)
+		delete(c.entries, evicted.key)
+		c.bytes -= evicted.size()
+	}
+}
+
+var pageCache = newResponseCache(cacheMaxBytes)
+
+// responseRecorder buffers a handler's response so it can be cached
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) Header() http.Header         { return rec.header }
+func (rec *responseRecorder) Write(b []byte) (int, error) { return rec.body.Write(b) }
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+// withCache serves GET responses from the page cache with ETag revalidation
+// and gzip/brotli compression. A request carrying "Cache-Control: no-cache"
+// skips the cache lookup and re-renders the page; NDJSON streams bypass it.
+func withCache(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Streamed responses are never buffered
+		if (r.Method != http.MethodGet && r.Method != http.MethodHead) || wantsNDJSON(r) {
+			next(w, r)
+			return
+		}
+
+		key := r.URL.Path + "?" + r.URL.Query().Encode()
+		entry := pageCache.get(key)
+		if entry == nil || strings.Contains(r.Header.Get("Cache-Control"), "no-cache") {
+			rec := &responseRecorder{header: make(http.Header)}
+			next(rec, r)
+			if rec.status != 0 && rec.status != http.StatusOK {
+				copyHeader(w.Header(), rec.header)
+				w.WriteHeader(rec.status)
+				w.Write(rec.body.Bytes())
+				return
+			}
+			entry = newCachedResponse(key, rec)
+			pageCache.add(entry)
+		}
+
+		serveCached(w, r, entry)
+	}
+}
+
+// newCachedResponse serializes a recorded response and its compressed forms
+func newCachedResponse(key string, rec *responseRecorder) *cachedResponse {
+	sum := sha256.Sum256([]byte(store.Version() + "\x00" + key))
+	entry := &cachedResponse{
+		key:    key,
+		etag:   `"` + hex.EncodeToString(sum[:12]) + `"`,
+		header: rec.header.Clone(),
+		body:   bytes.Clone(rec.body.Bytes()),
+	}
+	if len(entry.body) < compressMinBytes {
+		return entry
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write(entry.body)
+	if err := gz.Close(); err != nil {
+		log.Printf("Error gzipping %s: %v", key, err)
+	} else {
+		entry.gzip = bytes.Clone(buf.Bytes())
+	}
+
+	buf.Reset()
+	br := brotli.NewWriterLevel(&buf, brotli.DefaultCompression)
+	br.Write(entry.body)
+	if err := br.Close(); err != nil {
+		log.Printf("Error brotli-compressing %s: %v", key, err)
+	} else {
+		entry.brotli = bytes.Clone(buf.Bytes())
+	}
+	return entry
+}
+
+// serveCached writes a cached entry, honouring If-None-Match and Accept-Encoding
+func serveCached(w http.ResponseWriter, r *http.Request, entry *cachedResponse) {
+	h := w.Header()
+	copyHeader(h, entry.header)
+	h.Set("ETag", entry.etag)
+	h.Set("Cache-Control", "no-cache")
+	h.Add("Vary", "Accept, Accept-Encoding")
+
+	if etagMatches(r.Header.Get("If-None-Match"), entry.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	body := entry.body
+	acceptEncoding := r.Header.Get("Accept-Encoding")
+	switch {
+	case entry.brotli != nil && acceptsEncoding(acceptEncoding, "br"):
+		h.Set("Content-Encoding", "br")
+		body = entry.brotli
+	case entry.gzip != nil && acceptsEncoding(acceptEncoding, "gzip"):
+		h.Set("Content-Encoding", "gzip")
+		body = entry.gzip
+	}
+	h.Set("Content-Length", strconv.Itoa(len(body)))
+
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
+
+func copyHeader(dst, src http.Header) {
+	for k, v := range src {
+		dst[k] = append([]string(nil), v...)
+	}
+}
+
+// etagMatches checks an If-None-Match header value against an ETag
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsEncoding reports whether an Accept-Encoding header allows enc
+func acceptsEncoding(header, enc string) bool {
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), enc) {
+			continue
+		}
+		q := strings.TrimSpace(params)
+		return q != "q=0" && q != "q=0.0" && q != "q=0.00" && q != "q=0.000"
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+// client.go
+//
+// Package client is a typed Go client for the uniGo JSON API.
+//
+//	import "github.com/limpdev/limpbin/uniGo/client"
+//
+//	c := client.New("http://localhost:6969")
+//	resp, err := c.Characters(ctx, client.WithCategory("Lu"), client.WithLimit(50))
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/limpdev/limpbin/uniGo/unidata"
+)
+
+// Client talks to a uniGo server
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// New returns a Client for the server at baseURL (e.g. http://localhost:6969)
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// APIError is returned for non-2xx responses
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unigo: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Option sets a query parameter on a request
+type Option func(url.Values)
+
+// WithSearch matches names, code points or the literal character
+func WithSearch(search string) Option { return set("search", search) }
+
+// WithCategory filters by general category abbreviation like "Lu"
+func WithCategory(category string) Option { return set("category", category) }
+
+// WithIndicSyllabic filters by Indic_Syllabic_Category like "Vowel_Dependent"
+func WithIndicSyllabic(value string) Option { return set("indicSyllabic", value) }
+
+// WithIndicPositional filters by Indic_Positional_Category like "Left"
+func WithIndicPositional(value string) Option { return set("indicPositional", value) }
+
+// WithKind filters sequences by unidata.SequenceNamed or unidata.SequenceVariant
+func WithKind(kind string) Option { return set("kind", kind) }
+
+// WithPage selects a 1-based page
+func WithPage(page int) Option { return set("page", strconv.Itoa(page)) }
+
+// WithLimit sets the number of items per page
+func WithLimit(limit int) Option { return set("limit", strconv.Itoa(limit)) }
+
+// WithCursor selects cursor pagination; pass "" for the first page and
+// APIResponse.NextCursor for the following ones
+func WithCursor(cursor string) Option { return set("cursor", cursor) }
+
+func set(key, value string) Option {
+	return func(q url.Values) { q.Set(key, value) }
+}
+
+// Characters fetches one page of characters
+func (c *Client) Characters(ctx context.Context, opts ...Option) (*unidata.APIResponse, error) {
+	var resp unidata.APIResponse
+	if err := c.getJSON(ctx, "/api/characters", opts, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StreamCharacters calls fn for every matching character using the NDJSON
+// mode, without page limits. Returning an error from fn stops the stream.
+func (c *Client) StreamCharacters(ctx context.Context, fn func(unidata.CharacterInfo) error, opts ...Option) error {
+	req, err := c.newRequest(ctx, "/api/characters", opts)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/x-ndjson")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var info unidata.CharacterInfo
+		if err := json.Unmarshal(scanner.Bytes(), &info); err != nil {
+			return fmt.Errorf("unigo: decoding stream: %w", err)
+		}
+		if err := fn(info); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Metadata fetches the available categories and Indic property values
+func (c *Client) Metadata(ctx context.Context) (*unidata.MetadataResponse, error) {
+	var resp unidata.MetadataResponse
+	if err := c.getJSON(ctx, "/api/metadata", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Sequences fetches one page of named sequences and standardized variants
+func (c *Client) Sequences(ctx context.Context, opts ...Option) (*unidata.SequencesResponse, error) {
+	var resp unidata.SequencesResponse
+	if err := c.getJSON(ctx, "/api/sequences", opts, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Clusters splits text into Indic orthographic syllables
+func (c *Client) Clusters(ctx context.Context, text string) (*unidata.ClustersResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/api/indic/clusters", strings.NewReader(text))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	var resp unidata.ClustersResponse
+	if err := c.decode(req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Export fetches every matching character and sequence
+func (c *Client) Export(ctx context.Context, opts ...Option) ([]unidata.ExportEntry, error) {
+	var entries []unidata.ExportEntry
+	if err := c.getJSON(ctx, "/api/export", opts, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, path string, opts []Option) (*http.Request, error) {
+	query := url.Values{}
+	for _, opt := range opts {
+		opt(query)
+	}
+	u := c.BaseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, opts []Option, out any) error {
+	req, err := c.newRequest(ctx, path, opts)
+	if err != nil {
+		return err
+	}
+	return c.decode(req, out)
+}
+
+func (c *Client) decode(req *http.Request, out any) error {
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("unigo: decoding %s: %w", req.URL.Path, err)
+	}
+	return nil
+}
+
+// do sends req and turns non-2xx responses into an *APIError
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(msg))}
+	}
+	return resp, nil
+}
@@ -1,5 +1,7 @@
-module uniGo
+module github.com/limpdev/limpbin/uniGo
 
 go 1.24.2
 
 require golang.org/x/text v0.24.0
+
+require github.com/andybalholm/brotli v1.2.6
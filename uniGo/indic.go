@@ -0,0 +1,50 @@
+// indic.go
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/limpdev/limpbin/uniGo/unidata"
+)
+
+// maxClusterInput caps the size of text accepted by the clusters endpoint
+const maxClusterInput = 64 << 10
+
+// handleIndicClusters splits the "text" query parameter (or a POST body) into
+// orthographic syllables
+func handleIndicClusters(w http.ResponseWriter, r *http.Request) {
+	var text string
+	switch r.Method {
+	case http.MethodGet:
+		text = r.URL.Query().Get("text")
+	case http.MethodPost:
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxClusterInput))
+		if err != nil {
+			http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		text = string(body)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if len(text) > maxClusterInput {
+		http.Error(w, "Text too long", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	resp := unidata.ClustersResponse{
+		Text:     text,
+		Clusters: store.SegmentSyllables(text),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("Error encoding clusters JSON response: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,179 @@
+// loadtest/main.go
+//
+// Load test for a running uniGo server. Each common query is requested in
+// several modes so the effect of the page cache, compression and ETag
+// revalidation can be compared side by side:
+//
+//	go run ./loadtest -base http://localhost:6969 -n 500 -c 8
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// commonQueries are typical requests made by the UI and scripts
+var commonQueries = []string{
+	"/api/characters?page=1&limit=100",
+	"/api/characters?search=arrow&page=1&limit=100",
+	"/api/characters?category=Lu&page=3&limit=100",
+	"/api/metadata",
+}
+
+// mode describes how a request is sent
+type mode struct {
+	name        string
+	encoding    string
+	noCache     bool // Ask the server to bypass its page cache
+	conditional bool // Send If-None-Match with the ETag from a priming request
+}
+
+var modes = []mode{
+	{name: "uncached", noCache: true},
+	{name: "cached"},
+	{name: "cached+gzip", encoding: "gzip"},
+	{name: "cached+br", encoding: "br"},
+	{name: "revalidate", conditional: true},
+}
+
+// result summarises one query in one mode
+type result struct {
+	requests  int
+	failures  int
+	bytes     int64
+	latencies []time.Duration
+	elapsed   time.Duration
+}
+
+func main() {
+	base := flag.String("base", "http://localhost:6969", "Base URL of the uniGo server")
+	total := flag.Int("n", 200, "Requests per query and mode")
+	workers := flag.Int("c", 8, "Concurrent workers")
+	flag.Parse()
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		// Keep compressed bodies as-is so transferred bytes are measured
+		Transport: &http.Transport{DisableCompression: true, MaxIdleConnsPerHost: *workers},
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "query\tmode\treq/s\tp50\tp99\tbytes/req\tfailures\t")
+	for _, query := range commonQueries {
+		url := *base + query
+		etag, err := primeETag(client, url)
+		if err != nil {
+			log.Fatalf("Priming %s: %v", url, err)
+		}
+		for _, m := range modes {
+			res := run(client, url, etag, m, *total, *workers)
+			fmt.Fprintf(tw, "%s\t%s\t%.0f\t%s\t%s\t%d\t%d\t\n",
+				query, m.name,
+				float64(res.requests)/res.elapsed.Seconds(),
+				percentile(res.latencies, 0.50), percentile(res.latencies, 0.99),
+				res.bytes/int64(max(res.requests, 1)), res.failures)
+		}
+	}
+	tw.Flush()
+}
+
+// primeETag fetches url once so it is cached and returns its ETag
+func primeETag(client *http.Client, url string) (string, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.Header.Get("ETag"), nil
+}
+
+// run sends total requests for url in the given mode across workers goroutines
+func run(client *http.Client, url, etag string, m mode, total, workers int) result {
+	jobs := make(chan struct{}, total)
+	for range total {
+		jobs <- struct{}{}
+	}
+	close(jobs)
+
+	var mu sync.Mutex
+	res := result{latencies: make([]time.Duration, 0, total)}
+	var wg sync.WaitGroup
+
+	start := time.Now()
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				n, took, err := fetch(client, url, etag, m)
+				mu.Lock()
+				res.requests++
+				if err != nil {
+					res.failures++
+				} else {
+					res.bytes += n
+					res.latencies = append(res.latencies, took)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	res.elapsed = time.Since(start)
+	return res
+}
+
+// fetch performs one request and returns the body size and latency
+func fetch(client *http.Client, url, etag string, m mode) (int64, time.Duration, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	if m.encoding != "" {
+		req.Header.Set("Accept-Encoding", m.encoding)
+	}
+	if m.noCache {
+		req.Header.Set("Cache-Control", "no-cache")
+	}
+	if m.conditional && etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	n, err := io.Copy(io.Discard, resp.Body)
+	took := time.Since(start)
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotModified {
+		return 0, 0, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return n, took, nil
+}
+
+// percentile returns the p-th latency, rounded for display
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[int(p*float64(len(sorted)-1))].Round(time.Microsecond)
+}
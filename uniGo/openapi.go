@@ -0,0 +1,200 @@
+// openapi.go
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/limpdev/limpbin/uniGo/unidata"
+)
+
+// apiParam documents one query parameter of an API operation
+type apiParam struct {
+	name        string
+	kind        string // "string" or "integer"
+	description string
+}
+
+var (
+	searchParam     = apiParam{"search", "string", "Substring of the name or code point, or the literal text"}
+	categoryParam   = apiParam{"category", "string", "General category abbreviation like Lu"}
+	syllabicParam   = apiParam{"indicSyllabic", "string", "Indic_Syllabic_Category like Vowel_Dependent"}
+	positionalParam = apiParam{"indicPositional", "string", "Indic_Positional_Category like Left"}
+	kindParam       = apiParam{"kind", "string", `Sequence kind, "named" or "variant"`}
+	pageParam       = apiParam{"page", "integer", "1-based page number; out-of-range pages return 400"}
+	limitParam      = apiParam{"limit", "integer", "Items per page (default 100)"}
+)
+
+// apiOperation documents one GET endpoint, optionally also accepting a
+// POST, and the type it returns
+type apiOperation struct {
+	path        string
+	summary     string
+	params      []apiParam
+	response    reflect.Type
+	contentType string // Extra response media types besides JSON
+	postBody    string // Media type of a POST body standing in for the query, if accepted
+}
+
+var apiOperations = []apiOperation{
+	{
+		path:    "/api/characters",
+		summary: "List characters, paged by page number or cursor. Send Accept: application/x-ndjson to stream every match.",
+		params: []apiParam{searchParam, categoryParam, syllabicParam, positionalParam, pageParam, limitParam,
+			{"cursor", "string", "Opaque cursor from nextCursor; empty starts at the beginning"}},
+		response:    reflect.TypeFor[unidata.APIResponse](),
+		contentType: "application/x-ndjson",
+	},
+	{
+		path:     "/api/metadata",
+		summary:  "List the available categories and Indic property values.",
+		response: reflect.TypeFor[unidata.MetadataResponse](),
+	},
+	{
+		path:     "/api/sequences",
+		summary:  "List named sequences and standardized variants.",
+		params:   []apiParam{searchParam, kindParam, pageParam, limitParam},
+		response: reflect.TypeFor[unidata.SequencesResponse](),
+	},
+	{
+		path:     "/api/indic/clusters",
+		summary:  "Split text into orthographic syllables. The text may also be POSTed as the request body.",
+		params:   []apiParam{{"text", "string", "Text to segment"}},
+		response: reflect.TypeFor[unidata.ClustersResponse](),
+		postBody: "text/plain",
+	},
+	{
+		path:     "/api/export",
+		summary:  "Export every matching character and sequence. format=csv returns text/csv instead.",
+		params:   []apiParam{searchParam, categoryParam, syllabicParam, positionalParam, kindParam, {"format", "string", `"json" (default) or "csv"`}},
+		response: reflect.TypeFor[[]unidata.ExportEntry](),
+	},
+}
+
+// openAPISpec builds the OpenAPI 3 document from apiOperations and the
+// unidata response types, so the schemas never drift from the Go structs
+var openAPISpec = sync.OnceValue(func() []byte {
+	schemas := map[string]any{}
+	paths := map[string]any{}
+
+	for _, op := range apiOperations {
+		params := []any{}
+		for _, p := range op.params {
+			params = append(params, map[string]any{
+				"name":        p.name,
+				"in":          "query",
+				"description": p.description,
+				"schema":      map[string]any{"type": p.kind},
+			})
+		}
+
+		content := map[string]any{
+			"application/json": map[string]any{"schema": schemaFor(op.response, schemas)},
+		}
+		if op.contentType != "" {
+			content[op.contentType] = map[string]any{}
+		}
+
+		responses := map[string]any{
+			"200": map[string]any{"description": "OK", "content": content},
+			"400": map[string]any{"description": "Invalid parameters"},
+			"304": map[string]any{"description": "Not modified (If-None-Match matched the ETag)"},
+		}
+		item := map[string]any{
+			"get": map[string]any{
+				"summary":    op.summary,
+				"parameters": params,
+				"responses":  responses,
+			},
+		}
+		if op.postBody != "" {
+			item["post"] = map[string]any{
+				"summary": op.summary,
+				"requestBody": map[string]any{
+					"required": true,
+					"content": map[string]any{
+						op.postBody: map[string]any{"schema": map[string]any{"type": "string"}},
+					},
+				},
+				"responses": map[string]any{
+					"200": responses["200"],
+					"413": map[string]any{"description": "Request body too large"},
+				},
+			}
+		}
+		paths[op.path] = item
+	}
+
+	spec := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "uniGo",
+			"version": "1",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		log.Fatalf("Error encoding OpenAPI document: %v", err)
+	}
+	return data
+})
+
+// schemaFor returns the JSON schema for t, registering named structs as
+// components and referencing them by $ref
+func schemaFor(t reflect.Type, schemas map[string]any) map[string]any {
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaFor(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaFor(t.Elem(), schemas)}
+	case reflect.Struct:
+		ref := map[string]any{"$ref": "#/components/schemas/" + t.Name()}
+		if _, ok := schemas[t.Name()]; ok {
+			return ref
+		}
+		schemas[t.Name()] = nil // Placeholder guards against recursive types
+
+		properties := map[string]any{}
+		required := []string{}
+		for i := range t.NumField() {
+			field := t.Field(i)
+			name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			properties[name] = schemaFor(field.Type, schemas)
+			if !strings.Contains(opts, "omitempty") {
+				required = append(required, name)
+			}
+		}
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		schemas[t.Name()] = schema
+		return ref
+	default:
+		return map[string]any{}
+	}
+}
+
+// handleOpenAPI serves the generated OpenAPI document
+func handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPISpec())
+}
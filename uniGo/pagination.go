@@ -0,0 +1,85 @@
+// pagination.go
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/limpdev/limpbin/uniGo/unidata"
+)
+
+const (
+	cursorPrefix = "cp1:" // Versions the cursor format
+	ndjsonType   = "application/x-ndjson"
+	ndjsonFlush  = 256 // Lines written between flushes when streaming
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// encodeCursor returns an opaque cursor pointing just after code point r.
+// Cursors are keyed on code points rather than offsets, so they stay valid
+// when the filters change between requests.
+func encodeCursor(r rune) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.FormatInt(int64(r), 16)))
+}
+
+// decodeCursor returns the code point a cursor points after
+func decodeCursor(cursor string) (rune, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(raw), cursorPrefix) {
+		return 0, errInvalidCursor
+	}
+	v, err := strconv.ParseInt(strings.TrimPrefix(string(raw), cursorPrefix), 16, 32)
+	if err != nil || v < 0 || v > 0x10FFFF {
+		return 0, errInvalidCursor
+	}
+	return rune(v), nil
+}
+
+// charRune returns the code point of a character entry
+func charRune(c unidata.CharacterInfo) rune {
+	for _, r := range c.Char {
+		return r
+	}
+	return 0
+}
+
+// afterCursor returns the part of a code point ordered slice after r
+func afterCursor(chars []unidata.CharacterInfo, r rune) []unidata.CharacterInfo {
+	i := sort.Search(len(chars), func(i int) bool { return charRune(chars[i]) > r })
+	return chars[i:]
+}
+
+// wantsNDJSON reports whether the request's Accept header asks for NDJSON
+func wantsNDJSON(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(part); err == nil && mediaType == ndjsonType {
+			return true
+		}
+	}
+	return false
+}
+
+// streamNDJSON writes one JSON object per line, flushing as it goes
+func streamNDJSON(w http.ResponseWriter, chars []unidata.CharacterInfo) {
+	w.Header().Set("Content-Type", ndjsonType)
+	rc := http.NewResponseController(w)
+	enc := json.NewEncoder(w)
+	for i, c := range chars {
+		if err := enc.Encode(c); err != nil {
+			log.Printf("Error streaming NDJSON response: %v", err)
+			return
+		}
+		if (i+1)%ndjsonFlush == 0 {
+			rc.Flush()
+		}
+	}
+	rc.Flush()
+}
@@ -0,0 +1,120 @@
+// sequences.go
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/limpdev/limpbin/uniGo/unidata"
+)
+
+// sequenceFilterFromQuery reads the "search" and "kind" query parameters
+func sequenceFilterFromQuery(query url.Values) unidata.SequenceFilter {
+	return unidata.SequenceFilter{
+		Search: query.Get("search"),
+		Kind:   query.Get("kind"), // "named" or "variant"
+	}
+}
+
+// handleSequences serves named sequences and standardized variants
+func handleSequences(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100 // Default limit
+	}
+
+	filtered := store.FilterSequences(sequenceFilterFromQuery(query))
+	totalItems := len(filtered)
+	totalPages := (totalItems + limit - 1) / limit
+	if page > max(totalPages, 1) {
+		http.Error(w, fmt.Sprintf("Page %d out of range (1-%d)", page, max(totalPages, 1)), http.StatusBadRequest)
+		return
+	}
+	start := min((page-1)*limit, totalItems)
+	end := min(start+limit, totalItems)
+
+	resp := unidata.SequencesResponse{
+		Sequences:    filtered[start:end],
+		TotalItems:   totalItems,
+		CurrentPage:  page,
+		ItemsPerPage: limit,
+		TotalPages:   totalPages,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("Error encoding sequences JSON response: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
+
+// handleExport serves every character and sequence matching the filters as
+// JSON or, with format=csv, as a CSV download. Sequences are left out when a
+// character-only filter (category or Indic property) is set.
+func handleExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "csv" {
+		http.Error(w, "Unsupported format, expected json or csv", http.StatusBadRequest)
+		return
+	}
+
+	entries := []unidata.ExportEntry{}
+	if query.Get("kind") == "" {
+		for _, c := range store.FilterCharacters(characterFilterFromQuery(query)) {
+			entries = append(entries, unidata.ExportEntry{
+				Type:       "character",
+				Text:       c.Char,
+				CodePoints: []string{c.CodePoint},
+				Name:       c.Name,
+				Category:   c.CategoryAb,
+			})
+		}
+	}
+	if query.Get("category") == "" && query.Get("indicSyllabic") == "" && query.Get("indicPositional") == "" {
+		for _, s := range store.FilterSequences(sequenceFilterFromQuery(query)) {
+			entries = append(entries, unidata.ExportEntry{
+				Type:       "sequence",
+				Text:       s.Sequence,
+				CodePoints: s.CodePoints,
+				Name:       s.Name,
+				Kind:       s.Kind,
+			})
+		}
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="unicode-export.csv"`)
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"type", "text", "codePoints", "name", "kind", "category"})
+		for _, e := range entries {
+			cw.Write([]string{e.Type, e.Text, strings.Join(e.CodePoints, " "), e.Name, e.Kind, e.Category})
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			log.Printf("Error writing CSV export: %v", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		log.Printf("Error encoding export JSON response: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/limpdev/limpbin/uniGo/unidata"
+)
+
+func TestMain(m *testing.M) {
+	store = unidata.Load(unicodeDataDir)
+	os.Exit(m.Run())
+}
+
+// getCharacters calls handleCharacters with query and an optional Accept header
+func getCharacters(t *testing.T, query, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/characters?"+query, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	handleCharacters(rec, req)
+	return rec
+}
+
+func decodeCharacters(t *testing.T, rec *httptest.ResponseRecorder) unidata.APIResponse {
+	t.Helper()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var resp unidata.APIResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func TestCharactersInvalidCursor(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "!!!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte("cp1:410"))},
+		{"no prefix", encode("41")},
+		{"other version", encode("cp2:41")},
+		{"not hex", encode("cp1:zz")},
+		{"negative", encode("cp1:-1")},
+		{"beyond Unicode", encode("cp1:110000")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := getCharacters(t, "cursor="+tt.cursor, "")
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want 400", rec.Code)
+			}
+		})
+	}
+}
+
+func TestCharactersCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		limit string
+	}{
+		{"uneven pages", "search=digit", "7"},
+		{"category filter", "category=Nd", "25"},
+		{"single page", "search=digit", "100000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := decodeCharacters(t, getCharacters(t, tt.query+"&limit=100000", ""))
+			if want.TotalItems == 0 {
+				t.Fatal("the filter matches nothing")
+			}
+
+			var got []unidata.CharacterInfo
+			cursor := ""
+			for range want.TotalItems + 1 {
+				resp := decodeCharacters(t, getCharacters(t, tt.query+"&limit="+tt.limit+"&cursor="+cursor, ""))
+				if resp.CurrentPage != 0 || resp.TotalItems != want.TotalItems {
+					t.Errorf("currentPage = %d, totalItems = %d; want 0 and %d", resp.CurrentPage, resp.TotalItems, want.TotalItems)
+				}
+				got = append(got, resp.Characters...)
+				if cursor = resp.NextCursor; cursor == "" {
+					break
+				}
+			}
+			if len(got) != len(want.Characters) {
+				t.Fatalf("cursor pages hold %d characters, want %d", len(got), len(want.Characters))
+			}
+			for i := range got {
+				if got[i] != want.Characters[i] {
+					t.Fatalf("character %d = %s, want %s", i, got[i].CodePoint, want.Characters[i].CodePoint)
+				}
+			}
+		})
+	}
+}
+
+func TestCharactersNDJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		accept string
+	}{
+		{"plain Accept", "search=digit", "application/x-ndjson"},
+		{"Accept list with parameters", "category=Nd", "application/json;q=0.5, application/x-ndjson; q=1"},
+		{"no match", "search=no-such-character-name", "application/x-ndjson"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := decodeCharacters(t, getCharacters(t, tt.query+"&limit=100000", ""))
+
+			rec := getCharacters(t, tt.query+"&limit=1", tt.accept)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", rec.Code)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+				t.Errorf("Content-Type = %q, want application/x-ndjson", ct)
+			}
+			body := rec.Body.String()
+			if body != "" && !strings.HasSuffix(body, "\n") {
+				t.Error("stream does not end with a newline")
+			}
+
+			// The limit is ignored: every match is streamed, one object per line
+			var lines int
+			scanner := bufio.NewScanner(strings.NewReader(body))
+			for scanner.Scan() {
+				var c unidata.CharacterInfo
+				if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+					t.Fatalf("line %d: %s", lines+1, err)
+				}
+				if c != want.Characters[lines] {
+					t.Fatalf("line %d = %s, want %s", lines+1, c.CodePoint, want.Characters[lines].CodePoint)
+				}
+				lines++
+			}
+			if lines != want.TotalItems {
+				t.Errorf("%d lines, want %d", lines, want.TotalItems)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// indic.go
+package unidata
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"unicode"
+)
+
+// syllableExtenders are the syllabic categories that never start a new syllable
+var syllableExtenders = map[string]bool{
+	"Bindu":                      true,
+	"Cantillation_Mark":          true,
+	"Consonant_Final":            true,
+	"Consonant_Killer":           true,
+	"Consonant_Medial":           true,
+	"Consonant_Subjoined":        true,
+	"Consonant_Succeeding_Repha": true,
+	"Gemination_Mark":            true,
+	"Invisible_Stacker":          true,
+	"Joiner":                     true,
+	"Non_Joiner":                 true,
+	"Nukta":                      true,
+	"Pure_Killer":                true,
+	"Register_Shifter":           true,
+	"Reordering_Killer":          true,
+	"Syllable_Modifier":          true,
+	"Tone_Mark":                  true,
+	"Virama":                     true,
+	"Visarga":                    true,
+	"Vowel_Dependent":            true,
+}
+
+// conjunctConsonants are the syllabic categories that join a preceding virama
+var conjunctConsonants = map[string]bool{
+	"Consonant":                   true,
+	"Consonant_Dead":              true,
+	"Consonant_Head_Letter":       true,
+	"Consonant_Initial_Postfixed": true,
+	"Consonant_Placeholder":       true,
+	"Consonant_With_Stacker":      true,
+}
+
+// nonLinkingViramas are viramas that mark a dead consonant rather than form a
+// conjunct (Tamil pulli), so the next consonant starts its own syllable
+var nonLinkingViramas = map[rune]bool{
+	0x0BCD: true, // TAMIL SIGN VIRAMA
+}
+
+// loadIndicData reads the Indic syllabic and positional category files.
+// Missing files are logged and leave the properties empty.
+func (s *Store) loadIndicData(dir string) {
+	var err error
+	if s.indicSyllabic, err = loadPropertyFile(dir, "IndicSyllabicCategory.txt"); err != nil {
+		log.Printf("Warning: could not load Indic syllabic categories: %v", err)
+		s.indicSyllabic = map[rune]string{}
+	}
+	if s.indicPositional, err = loadPropertyFile(dir, "IndicPositionalCategory.txt"); err != nil {
+		log.Printf("Warning: could not load Indic positional categories: %v", err)
+		s.indicPositional = map[rune]string{}
+	}
+	log.Printf("Loaded Indic categories for %d syllabic and %d positional code points.", len(s.indicSyllabic), len(s.indicPositional))
+}
+
+// IndicSyllabicCategories returns the distinct Indic_Syllabic_Category values
+func (s *Store) IndicSyllabicCategories() []string {
+	return propertyValues(s.indicSyllabic)
+}
+
+// IndicPositionalCategories returns the distinct Indic_Positional_Category values
+func (s *Store) IndicPositionalCategories() []string {
+	return propertyValues(s.indicPositional)
+}
+
+// SyllabicCategory returns the Indic_Syllabic_Category for a rune
+func (s *Store) SyllabicCategory(r rune) string {
+	if cat, ok := s.indicSyllabic[r]; ok {
+		return cat
+	}
+	return "Other"
+}
+
+// propertyValues returns the sorted distinct values of a property map
+func propertyValues(m map[rune]string) []string {
+	seen := make(map[string]bool)
+	values := []string{}
+	for _, v := range m {
+		if !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// SegmentSyllables splits text into orthographic syllables. A syllable starts
+// at any character that is not a dependent sign or mark, except for consonants
+// following a linking virama (or virama plus ZWJ), which join the conjunct.
+func (s *Store) SegmentSyllables(text string) []IndicCluster {
+	clusters := []IndicCluster{}
+	var current []rune
+	linked := false // previous rune links the next consonant into a conjunct
+
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		cluster := IndicCluster{
+			Text:       string(current),
+			CodePoints: make([]string, len(current)),
+			Syllabic:   make([]string, len(current)),
+		}
+		for i, r := range current {
+			cluster.CodePoints[i] = fmt.Sprintf("U+%04X", r)
+			cluster.Syllabic[i] = s.SyllabicCategory(r)
+		}
+		clusters = append(clusters, cluster)
+		current = nil
+	}
+
+	for _, r := range text {
+		cat := s.SyllabicCategory(r)
+		extends := syllableExtenders[cat] || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me)
+		if len(current) == 0 || !(extends || (linked && conjunctConsonants[cat])) {
+			flush()
+		}
+		current = append(current, r)
+
+		switch {
+		case cat == "Invisible_Stacker", cat == "Virama" && !nonLinkingViramas[r]:
+			linked = true
+		case cat == "Joiner":
+			// ZWJ after a virama keeps the link (explicit half forms)
+		default:
+			linked = false
+		}
+	}
+	flush()
+
+	return clusters
+}
@@ -0,0 +1,149 @@
+// sequences.go
+package unidata
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+// isVariationSelector reports whether r is in VS1-VS16 or VS17-VS256
+func isVariationSelector(r rune) bool {
+	return (r >= 0xFE00 && r <= 0xFE0F) || (r >= 0xE0100 && r <= 0xE01EF)
+}
+
+// newSequenceInfo builds the common fields of a sequence from its code points
+func newSequenceInfo(kind, name, codePoints string) (SequenceInfo, error) {
+	seq := SequenceInfo{Name: name, Kind: kind}
+	var sb strings.Builder
+	for _, cp := range strings.Fields(codePoints) {
+		r, err := parseCodePoint(cp)
+		if err != nil {
+			return seq, err
+		}
+		sb.WriteRune(r)
+		seq.CodePoints = append(seq.CodePoints, fmt.Sprintf("U+%04X", r))
+		if seq.VariationSelector == "" && isVariationSelector(r) {
+			seq.VariationSelector = fmt.Sprintf("U+%04X", r)
+		}
+	}
+	if len(seq.CodePoints) < 2 {
+		return seq, fmt.Errorf("sequence %q has fewer than 2 code points", codePoints)
+	}
+	seq.Sequence = sb.String()
+	return seq, nil
+}
+
+// loadNamedSequences reads a "NAME;code points" named sequence file
+func loadNamedSequences(dir, name string, provisional bool) ([]SequenceInfo, error) {
+	var seqs []SequenceInfo
+	err := readUCDFile(dir, name, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		seq, err := newSequenceInfo(SequenceNamed, fields[0], fields[1])
+		if err != nil {
+			return err
+		}
+		seq.Provisional = provisional
+		seqs = append(seqs, seq)
+		return nil
+	})
+	return seqs, err
+}
+
+// loadVariantSequences reads a "code points; description; environments" file
+func loadVariantSequences(dir, name string) ([]SequenceInfo, error) {
+	var seqs []SequenceInfo
+	err := readUCDFile(dir, name, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected at least 2 fields, got %d", len(fields))
+		}
+		seq, err := newSequenceInfo(SequenceVariant, "", fields[0])
+		if err != nil {
+			return err
+		}
+		base := []rune(seq.Sequence)[0]
+		seq.Name = runenames.Name(base)
+		seq.Description = fields[1]
+		if seq.Description != "" {
+			seq.Name += " (" + seq.Description + ")"
+		}
+		if len(fields) > 2 {
+			seq.Environments = strings.Fields(fields[2])
+		}
+		seqs = append(seqs, seq)
+		return nil
+	})
+	return seqs, err
+}
+
+// loadSequenceData reads every named and standardized variant sequence file.
+// Missing files are logged and skipped.
+func (s *Store) loadSequenceData(dir string) {
+	s.sequences = []SequenceInfo{}
+
+	named := []struct {
+		file        string
+		provisional bool
+	}{
+		{"NamedSequences.txt", false},
+		{"NamedSequencesProv.txt", true},
+	}
+	for _, n := range named {
+		seqs, err := loadNamedSequences(dir, n.file, n.provisional)
+		if err != nil {
+			log.Printf("Warning: could not load %s: %v", n.file, err)
+			continue
+		}
+		s.sequences = append(s.sequences, seqs...)
+	}
+
+	for _, file := range []string{"StandardizedVariants.txt", "emoji/emoji-variation-sequences.txt"} {
+		seqs, err := loadVariantSequences(dir, file)
+		if err != nil {
+			log.Printf("Warning: could not load %s: %v", file, err)
+			continue
+		}
+		s.sequences = append(s.sequences, seqs...)
+	}
+
+	log.Printf("Loaded %d sequences.", len(s.sequences))
+}
+
+// Sequences returns every loaded named sequence and standardized variant
+func (s *Store) Sequences() []SequenceInfo {
+	return s.sequences
+}
+
+// FilterSequences returns the sequences matching f, in file order
+func (s *Store) FilterSequences(f SequenceFilter) []SequenceInfo {
+	search := strings.ToLower(strings.TrimSpace(f.Search))
+
+	filtered := make([]SequenceInfo, 0, len(s.sequences))
+	for _, seq := range s.sequences {
+		if f.Kind != "" && seq.Kind != f.Kind {
+			continue
+		}
+		if search != "" && !sequenceMatches(seq, search) {
+			continue
+		}
+		filtered = append(filtered, seq)
+	}
+	return filtered
+}
+
+// sequenceMatches checks the name, description, code points and literal text
+func sequenceMatches(seq SequenceInfo, search string) bool {
+	if strings.Contains(strings.ToLower(seq.Name), search) || seq.Sequence == search {
+		return true
+	}
+	for _, cp := range seq.CodePoints {
+		if strings.Contains(strings.ToLower(cp), search) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,254 @@
+// store.go
+package unidata
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/runenames" // For character names
+)
+
+// Store holds the loaded Unicode data. It is read-only once Load returns,
+// so it is safe for concurrent use.
+type Store struct {
+	characters      []CharacterInfo
+	categories      map[string]string // Map Abbreviation -> Full Name
+	sequences       []SequenceInfo
+	indicSyllabic   map[rune]string // Indic_Syllabic_Category; absent code points read as ""
+	indicPositional map[rune]string // Indic_Positional_Category; absent code points read as ""
+	version         string
+}
+
+// Map of Unicode categories with their full names
+var categoryNames = map[string]string{
+	"Lu": "Uppercase Letter",
+	"Ll": "Lowercase Letter",
+	"Lt": "Titlecase Letter",
+	"Lm": "Modifier Letter",
+	"Lo": "Other Letter",
+	"Mn": "Nonspacing Mark",
+	"Mc": "Spacing Mark",
+	"Me": "Enclosing Mark",
+	"Nd": "Decimal Number",
+	"Nl": "Letter Number",
+	"No": "Other Number",
+	"Pc": "Connector Punctuation",
+	"Pd": "Dash Punctuation",
+	"Ps": "Open Punctuation",
+	"Pe": "Close Punctuation",
+	"Pi": "Initial Punctuation",
+	"Pf": "Final Punctuation",
+	"Po": "Other Punctuation",
+	"Sm": "Math Symbol",
+	"Sc": "Currency Symbol",
+	"Sk": "Modifier Symbol",
+	"So": "Other Symbol",
+	"Zs": "Space Separator",
+	"Zl": "Line Separator",
+	"Zp": "Paragraph Separator",
+	"Cc": "Control",
+	"Cf": "Format",
+	"Cs": "Surrogate",
+	"Co": "Private Use",
+	"Cn": "Unassigned",
+}
+
+// Load builds a Store from the UCD files in dir (usually "Unicodes").
+// Missing or malformed files are logged and leave their properties empty.
+func Load(dir string) *Store {
+	log.Println("Loading Unicode data...")
+	s := &Store{
+		characters: []CharacterInfo{},
+		categories: make(map[string]string),
+	}
+	addedCategories := make(map[string]bool)
+
+	s.loadIndicData(dir)
+	s.loadSequenceData(dir)
+
+	// Iterate through a relevant range (e.g., BMP 0x0000 to 0xFFFF)
+	for r := rune(0); r <= 0xFFFF; r++ {
+		if !unicode.IsPrint(r) || unicode.IsControl(r) || (unicode.IsSpace(r) && r != ' ') {
+			// Skip non-printable, control chars (except space)
+			// Add more exclusion logic if needed (e.g., surrogates)
+			if r >= 0xD800 && r <= 0xDFFF { // Skip surrogate pairs
+				continue
+			}
+
+			// Skip private use area for general browsing
+			if r >= 0xE000 && r <= 0xF8FF {
+				continue
+			}
+
+			// Skip combining marks unless you specifically want them displayed standalone
+			// if unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) {
+			// continue // Uncomment to skip combining marks
+			// }
+
+			if r == '\uFFFD' { // Skip replacement character
+				continue
+			}
+
+			// Skip characters known to cause issues or be unrenderable in many contexts
+			if r == 0xAD { // Soft hyphen
+				continue
+			}
+			if r >= 0x2060 && r <= 0x206F { // General punctuation invisible operators
+				continue
+			}
+			if r >= 0xFFF9 && r <= 0xFFFB { // Interlinear annotation anchors etc
+				continue
+			}
+			continue // Default skip if not printable or otherwise undesirable
+		}
+
+		name := runenames.Name(r)
+		if name == "" || strings.Contains(name, "<") { // Skip reserved/private use/control names
+			continue
+		}
+
+		// Get character category
+		catAb := getCategoryAbbreviation(r)
+		catName := categoryNames[catAb]
+		if catName == "" {
+			catName = "Unknown Category"
+		}
+
+		info := CharacterInfo{
+			Char:            string(r),
+			CodePoint:       fmt.Sprintf("U+%04X", r),
+			Name:            name,
+			Category:        catName,
+			CategoryAb:      catAb,
+			IndicSyllabic:   s.indicSyllabic[r],
+			IndicPositional: s.indicPositional[r],
+		}
+		s.characters = append(s.characters, info)
+
+		// Collect unique categories
+		if !addedCategories[catAb] {
+			s.categories[catAb] = catName
+			addedCategories[catAb] = true
+		}
+	}
+
+	s.version = s.computeVersion()
+	log.Printf("Loaded %d characters and %d categories (data version %s).", len(s.characters), len(s.categories), s.version)
+	return s
+}
+
+// computeVersion hashes the loaded characters and sequences
+func (s *Store) computeVersion() string {
+	h := sha256.New()
+	enc := json.NewEncoder(h)
+	enc.Encode(s.characters)
+	enc.Encode(s.sequences)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// Version identifies the loaded data set
+func (s *Store) Version() string {
+	return s.version
+}
+
+// Characters returns every loaded character in code point order
+func (s *Store) Characters() []CharacterInfo {
+	return s.characters
+}
+
+// Categories returns the categories present, abbreviation -> full name
+func (s *Store) Categories() map[string]string {
+	return s.categories
+}
+
+// SortedCategoryKeys returns category abbreviations ordered by full name
+func (s *Store) SortedCategoryKeys() []string {
+	catKeys := make([]string, 0, len(s.categories))
+	for k := range s.categories {
+		catKeys = append(catKeys, k)
+	}
+	sort.Slice(catKeys, func(i, j int) bool {
+		// Sort by full name for user-friendliness
+		return s.categories[catKeys[i]] < s.categories[catKeys[j]]
+	})
+	return catKeys
+}
+
+// getCategoryAbbreviation returns the two-letter Unicode category for a rune
+func getCategoryAbbreviation(r rune) string {
+	// Check for specific category ranges
+	switch {
+	case unicode.IsLetter(r):
+		if unicode.IsUpper(r) {
+			return "Lu"
+		} else if unicode.IsLower(r) {
+			return "Ll"
+		} else if unicode.IsTitle(r) {
+			return "Lt"
+		} else {
+			// Further differentiate between Lm and Lo if needed
+			return "Lo" // Default to "Other Letter"
+		}
+	case unicode.IsDigit(r):
+		return "Nd"
+	case unicode.IsPunct(r):
+		// This is simplified - ideally you'd distinguish between different punctuation types
+		return "Po"
+	case unicode.IsSymbol(r):
+		// This is simplified - ideally you'd distinguish between different symbol types
+		if strings.Contains(runenames.Name(r), "CURRENCY") {
+			return "Sc"
+		}
+		return "So"
+	case unicode.IsSpace(r):
+		return "Zs"
+	case unicode.IsControl(r):
+		return "Cc"
+	default:
+		return "Cn" // Unassigned as fallback
+	}
+}
+
+// FilterCharacters returns the characters matching f, in code point order
+func (s *Store) FilterCharacters(f CharacterFilter) []CharacterInfo {
+	search := strings.ToLower(strings.TrimSpace(f.Search))
+
+	filtered := make([]CharacterInfo, 0, len(s.characters))
+	for _, charInfo := range s.characters {
+		match := true
+
+		// Search filter (checks name, codepoint)
+		if search != "" {
+			nameLower := strings.ToLower(charInfo.Name)
+			codeLower := strings.ToLower(charInfo.CodePoint)
+			// Basic substring search, could be improved (e.g., word boundary)
+			if !strings.Contains(nameLower, search) && !strings.Contains(codeLower, search) && charInfo.Char != search {
+				match = false
+			}
+		}
+
+		// Category filter
+		if match && f.Category != "" && charInfo.CategoryAb != f.Category {
+			match = false
+		}
+
+		// Indic category filters
+		if match && f.IndicSyllabic != "" && charInfo.IndicSyllabic != f.IndicSyllabic {
+			match = false
+		}
+		if match && f.IndicPositional != "" && charInfo.IndicPositional != f.IndicPositional {
+			match = false
+		}
+
+		if match {
+			filtered = append(filtered, charInfo)
+		}
+	}
+	return filtered
+}
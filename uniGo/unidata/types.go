@@ -0,0 +1,96 @@
+// types.go
+package unidata
+
+// CharacterInfo holds data about a single Unicode character
+type CharacterInfo struct {
+	Char            string `json:"char"`
+	CodePoint       string `json:"codePoint"` // Hex representation like U+0041
+	Name            string `json:"name"`
+	Category        string `json:"category"`
+	CategoryAb      string `json:"categoryAb"`                // Abbreviation like Lu
+	IndicSyllabic   string `json:"indicSyllabic,omitempty"`   // Indic_Syllabic_Category like Vowel_Dependent
+	IndicPositional string `json:"indicPositional,omitempty"` // Indic_Positional_Category like Right
+	// BlockName string `json:"blockName"` // Block info is harder to get reliably without external data
+}
+
+// APIResponse structures the JSON response for the characters endpoint
+type APIResponse struct {
+	Characters   []CharacterInfo `json:"characters"`
+	TotalItems   int             `json:"totalItems"`
+	CurrentPage  int             `json:"currentPage"`
+	ItemsPerPage int             `json:"itemsPerPage"`
+	TotalPages   int             `json:"totalPages"`
+	NextCursor   string          `json:"nextCursor,omitempty"` // Opaque cursor for the following page
+}
+
+// MetadataResponse structures the JSON response for metadata
+type MetadataResponse struct {
+	Categories                map[string]string `json:"categories"`       // Map Abbreviation -> Full Name
+	Blocks                    []string          `json:"blocks,omitempty"` // Unicode blocks, not loaded yet so always omitted
+	IndicSyllabicCategories   []string          `json:"indicSyllabicCategories"`
+	IndicPositionalCategories []string          `json:"indicPositionalCategories"`
+}
+
+// Sequence kinds
+const (
+	SequenceNamed   = "named"   // NamedSequences.txt / NamedSequencesProv.txt
+	SequenceVariant = "variant" // StandardizedVariants.txt / emoji-variation-sequences.txt
+)
+
+// SequenceInfo holds data about a multi-codepoint entity
+type SequenceInfo struct {
+	Sequence          string   `json:"sequence"`
+	CodePoints        []string `json:"codePoints"` // Hex representations like U+0030
+	Name              string   `json:"name"`
+	Kind              string   `json:"kind"`                        // "named" or "variant"
+	Provisional       bool     `json:"provisional,omitempty"`       // From NamedSequencesProv.txt
+	VariationSelector string   `json:"variationSelector,omitempty"` // Selector code point like U+FE0F
+	Description       string   `json:"description,omitempty"`       // Variant appearance like "emoji style"
+	Environments      []string `json:"environments,omitempty"`      // Shaping environments like "isolate"
+}
+
+// SequencesResponse structures the JSON response for the sequences endpoint
+type SequencesResponse struct {
+	Sequences    []SequenceInfo `json:"sequences"`
+	TotalItems   int            `json:"totalItems"`
+	CurrentPage  int            `json:"currentPage"`
+	ItemsPerPage int            `json:"itemsPerPage"`
+	TotalPages   int            `json:"totalPages"`
+}
+
+// IndicCluster is one orthographic syllable split out of the input text
+type IndicCluster struct {
+	Text       string   `json:"text"`
+	CodePoints []string `json:"codePoints"`
+	Syllabic   []string `json:"syllabic"` // Indic_Syllabic_Category per code point
+}
+
+// ClustersResponse structures the JSON response for the clusters endpoint
+type ClustersResponse struct {
+	Text     string         `json:"text"`
+	Clusters []IndicCluster `json:"clusters"`
+}
+
+// ExportEntry is one row of an export, either a character or a sequence
+type ExportEntry struct {
+	Type       string   `json:"type"` // "character" or "sequence"
+	Text       string   `json:"text"`
+	CodePoints []string `json:"codePoints"`
+	Name       string   `json:"name"`
+	Kind       string   `json:"kind,omitempty"`
+	Category   string   `json:"category,omitempty"`
+}
+
+// CharacterFilter selects characters; empty fields match everything
+type CharacterFilter struct {
+	Search          string // Substring of the name or code point, or the character itself
+	Category        string // Category abbreviation like Lu
+	IndicSyllabic   string // Indic_Syllabic_Category like Vowel_Dependent
+	IndicPositional string // Indic_Positional_Category like Left
+}
+
+// SequenceFilter selects sequences; empty fields match everything
+type SequenceFilter struct {
+	Search string // Substring of the name or a code point, or the sequence itself
+	Kind   string // SequenceNamed or SequenceVariant
+}
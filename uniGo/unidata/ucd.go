@@ -0,0 +1,89 @@
+// ucd.go
+package unidata
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// readUCDFile calls fn with the semicolon-separated fields of every data line
+// in a UCD text file under dir, with comments stripped and fields trimmed
+func readUCDFile(dir, name string, fn func(fields []string) error) error {
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if err := fn(fields); err != nil {
+			return fmt.Errorf("%s:%d: %w", name, lineNo, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// parseCodePoint parses a bare hex code point like "0915"
+func parseCodePoint(s string) (rune, error) {
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return rune(v), nil
+}
+
+// parseCodePointRange parses "0900..0902" or a single code point like "0903"
+func parseCodePointRange(s string) (first, last rune, err error) {
+	lo, hi, isRange := strings.Cut(s, "..")
+	if first, err = parseCodePoint(lo); err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return first, first, nil
+	}
+	if last, err = parseCodePoint(hi); err != nil {
+		return 0, 0, err
+	}
+	return first, last, nil
+}
+
+// loadPropertyFile reads a "range ; value" UCD property file into a rune map
+func loadPropertyFile(dir, name string) (map[rune]string, error) {
+	values := make(map[rune]string)
+	err := readUCDFile(dir, name, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		first, last, err := parseCodePointRange(fields[0])
+		if err != nil {
+			return err
+		}
+		for r := first; r <= last; r++ {
+			values[r] = fields[1]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}